@@ -29,7 +29,7 @@ func main() {
 				if _, ok := sds[key]; ok {
 					return nil
 				}
-				globalKeysRes[sl[3]] = nil
+				globalKeysDS[sl[3]] = nil
 				dsk = append(dsk, key)
 				sds[key] = strings.Join(sl[:len(sl)-1], "/")
 			}
@@ -39,7 +39,7 @@ func main() {
 				if _, ok := sr[key]; ok {
 					return nil
 				}
-				globalKeysDS[sl[3]] = nil
+				globalKeysRes[sl[3]] = nil
 				rk = append(rk, key)
 				sr[key] = strings.Join(sl[:len(sl)-1], "/")
 			}
@@ -77,8 +77,8 @@ func main() {
 	}
 	sData := string(data)
 
-	dsstr := printDataSourceOutcome(sortedGlobalKeysDS, dsk, sds, "datasource-")
-	resstr, deleteNeeds := printResourceOutcome(sortedGlobalKeysRes, rk, sr, "resource-", "datasource-")
+	dsstr, dsJobs := printDataSourceOutcome(sortedGlobalKeysDS, dsk, sds, "datasource-")
+	resstr, deleteNeeds := printResourceOutcome(sortedGlobalKeysRes, rk, sr, "resource-", "datasource-", dsJobs)
 	sData = strings.Replace(sData, "__data_sources__", dsstr, 1)
 	sData = strings.Replace(sData, "__resources__", resstr, 1)
 	sData = strings.Replace(sData, "__delete_needs__", deleteNeeds, 2)
@@ -89,7 +89,8 @@ func main() {
 	}
 }
 
-func printDataSourceOutcome(sortedglobalKeys []string, sortedKeys []string, keyAndPathMap map[string]string, prefix string) string {
+// printDataSourceOutcome returns the data source jobs and their names
+func printDataSourceOutcome(sortedglobalKeys []string, sortedKeys []string, keyAndPathMap map[string]string, prefix string) (string, []string) {
 	s := ""
 	nextNeeds := []string{"datasources"}
 
@@ -212,11 +213,13 @@ func printDataSourceOutcome(sortedglobalKeys []string, sortedKeys []string, keyA
           path: .github/files/analyze-test-output/result/*.json
 `, strings.Join(collectedNames, ","), incl)
 
-	return s
+	return s, nextNeeds
 
 }
 
-func printResourceOutcome(sortedglobalKeys []string, sortedKeys []string, keyAndPathMap map[string]string, prefix, previousPrefix string) (string, string) {
+// printResourceOutcome returns the resource jobs and the needs of the delete job
+// restricted resource jobs wait for the data source job of the same service, or the shared data source job if there's none
+func printResourceOutcome(sortedglobalKeys []string, sortedKeys []string, keyAndPathMap map[string]string, prefix, previousPrefix string, previousJobs []string) (string, string) {
 	s := ""
 	nextNeeds := []string{"resources"}
 
@@ -241,6 +244,12 @@ func printResourceOutcome(sortedglobalKeys []string, sortedKeys []string, keyAnd
 		}
 		sort.Strings(names)
 		nextNeeds = append(nextNeeds, prefix+id)
+		previous := previousJobs[0]
+		for _, job := range previousJobs {
+			if job == previousPrefix+id {
+				previous = job
+			}
+		}
 		incl := ""
 		for _, n := range names {
 			incl = incl + fmt.Sprintf(`
@@ -258,7 +267,7 @@ func printResourceOutcome(sortedglobalKeys []string, sortedKeys []string, keyAnd
         include:
 %s
     name: ${{ matrix.name }} resource
-    needs: [createproject,%s%s]
+    needs: [createproject,%s]
     runs-on: ubuntu-latest
     if: always()
     steps:
@@ -282,7 +291,7 @@ func printResourceOutcome(sortedglobalKeys []string, sortedKeys []string, keyAnd
         uses: actions/upload-artifact@v3
         with:
           path: .github/files/analyze-test-output/result/*.json
-`, prefix, id, strings.Join(names, ","), incl, previousPrefix, id)
+`, prefix, id, strings.Join(names, ","), incl, previous)
 	}
 
 	// handle non restricted matrix
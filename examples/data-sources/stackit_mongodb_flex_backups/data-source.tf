@@ -0,0 +1,10 @@
+resource "stackit_mongodb_flex_instance" "example" {
+  project_id   = var.project_id
+  name         = "example"
+  machine_type = "1.1"
+}
+
+data "stackit_mongodb_flex_backups" "example" {
+  project_id  = var.project_id
+  instance_id = stackit_mongodb_flex_instance.example.id
+}
@@ -0,0 +1,4 @@
+data "stackit_mongodb_flex_storages" "example" {
+  project_id   = var.project_id
+  machine_type = "1.1"
+}
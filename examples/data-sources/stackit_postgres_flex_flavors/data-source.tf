@@ -0,0 +1,3 @@
+data "stackit_postgres_flex_flavors" "example" {
+  project_id = var.project_id
+}
@@ -0,0 +1,4 @@
+data "stackit_postgres_flex_storages" "example" {
+  project_id   = var.project_id
+  machine_type = "2.4"
+}
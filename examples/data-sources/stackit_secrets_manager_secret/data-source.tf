@@ -0,0 +1,10 @@
+data "stackit_secrets_manager_secret" "example" {
+  api_url     = stackit_secrets_manager_instance.example.api_url
+  instance_id = stackit_secrets_manager_instance.example.id
+  username    = stackit_secrets_manager_user.example.username
+  password    = stackit_secrets_manager_user.example.password
+  path        = "app/database"
+
+  # optional, the latest version is read by default
+  version = 1
+}
@@ -0,0 +1,11 @@
+resource "stackit_argus_instance" "example" {
+  project_id = var.project_id
+  name       = "example"
+  plan       = "Monitoring-Medium-EU01"
+}
+
+# the credential is deleted once Terraform is done with it
+ephemeral "stackit_argus_credential" "example" {
+  project_id  = var.project_id
+  instance_id = stackit_argus_instance.example.id
+}
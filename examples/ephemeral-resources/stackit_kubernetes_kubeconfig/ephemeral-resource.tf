@@ -0,0 +1,11 @@
+ephemeral "stackit_kubernetes_kubeconfig" "example" {
+  project_id   = var.project_id
+  cluster_name = "example"
+}
+
+provider "kubernetes" {
+  host                   = provider::stackit::parse_kubeconfig(ephemeral.stackit_kubernetes_kubeconfig.example.kube_config).host
+  cluster_ca_certificate = provider::stackit::parse_kubeconfig(ephemeral.stackit_kubernetes_kubeconfig.example.kube_config).cluster_ca_certificate
+  client_certificate     = provider::stackit::parse_kubeconfig(ephemeral.stackit_kubernetes_kubeconfig.example.kube_config).client_certificate
+  client_key             = provider::stackit::parse_kubeconfig(ephemeral.stackit_kubernetes_kubeconfig.example.kube_config).client_key
+}
@@ -0,0 +1,6 @@
+# resets the password of an existing user
+ephemeral "stackit_mongodb_flex_user_password" "example" {
+  project_id  = var.project_id
+  instance_id = stackit_mongodb_flex_instance.example.id
+  user_id     = stackit_mongodb_flex_user.example.id
+}
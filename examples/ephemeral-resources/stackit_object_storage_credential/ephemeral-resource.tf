@@ -0,0 +1,5 @@
+# the access key is deleted once Terraform is done with it
+ephemeral "stackit_object_storage_credential" "example" {
+  project_id = var.project_id
+  expiry     = "2030-01-01T00:00:00Z"
+}
@@ -0,0 +1,12 @@
+resource "stackit_secrets_manager_instance" "example" {
+  project_id = var.project_id
+  name       = "example"
+}
+
+# the user is deleted once Terraform is done with it
+ephemeral "stackit_secrets_manager_user" "example" {
+  project_id    = var.project_id
+  instance_id   = stackit_secrets_manager_instance.example.id
+  description   = "terraform"
+  write_enabled = false
+}
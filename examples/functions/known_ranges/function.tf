@@ -0,0 +1,6 @@
+resource "stackit_postgres_flex_instance" "example" {
+  name         = "example"
+  project_id   = "example"
+  machine_type = "2.4"
+  acl          = concat(provider::stackit::known_ranges(), ["203.0.113.0/24"])
+}
@@ -0,0 +1,10 @@
+locals {
+  kube = provider::stackit::parse_kubeconfig(stackit_kubernetes_cluster.example.kube_config)
+}
+
+provider "kubernetes" {
+  host                   = local.kube.host
+  cluster_ca_certificate = local.kube.cluster_ca_certificate
+  client_certificate     = local.kube.client_certificate
+  client_key             = local.kube.client_key
+}
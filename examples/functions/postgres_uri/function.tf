@@ -0,0 +1,10 @@
+output "uri" {
+  sensitive = true
+  value = provider::stackit::postgres_uri(
+    stackit_postgres_flex_user.example.host,
+    stackit_postgres_flex_user.example.port,
+    stackit_postgres_flex_user.example.username,
+    stackit_postgres_flex_user.example.password,
+    "postgres",
+  )
+}
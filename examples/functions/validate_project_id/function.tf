@@ -0,0 +1,8 @@
+variable "project_id" {
+  type = string
+
+  validation {
+    condition     = provider::stackit::validate_project_id(var.project_id)
+    error_message = "project_id must be a valid STACKIT project ID."
+  }
+}
@@ -17,3 +17,20 @@ provider "stackit" {
   service_account_key = var.service_account_key
   private_key         = var.private_key
 }
+
+# OIDC flow, e.g. in a CI job with a token federated with the service account
+provider "stackit" {
+  use_oidc              = true
+  service_account_email = var.service_account_email
+  oidc_token_file_path  = var.oidc_token_file_path
+}
+
+# Credentials file profile with custom endpoints, e.g. for a second environment
+provider "stackit" {
+  alias   = "qa"
+  profile = "qa"
+
+  endpoints {
+    ske = "https://ske.api.qa.stackit.cloud"
+  }
+}
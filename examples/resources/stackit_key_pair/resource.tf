@@ -0,0 +1,4 @@
+resource "stackit_key_pair" "example" {
+  name       = "example"
+  public_key = file("~/.ssh/id_ed25519.pub")
+}
@@ -9,12 +9,22 @@ resource "stackit_load_balancer" "example" {
       display_name = "example-target"
       ip_address   = openstack_compute_instance_v2.example.network.0.fixed_ip_v4
     }]
+    session_persistence = {
+      use_source_ip_address = true
+    }
   }]
   listeners = [{
     display_name = "example-listener"
     port         = 80
     protocol     = "PROTOCOL_TCP"
     target_pool  = "example-target-pool"
+    idle_timeout = "300s"
+    }, {
+    display_name           = "example-tls-listener"
+    port                   = 443
+    protocol               = "PROTOCOL_TLS_PASSTHROUGH"
+    target_pool            = "example-target-pool"
+    server_name_indicators = ["example.com"]
   }]
   networks = [
     { network_id = openstack_networking_network_v2.example.id }
@@ -0,0 +1,40 @@
+resource "stackit_argus_instance" "example" {
+  project_id = var.project_id
+  name       = "example"
+  plan       = "Monitoring-Medium-EU01"
+}
+
+resource "stackit_argus_credential" "example" {
+  project_id  = var.project_id
+  instance_id = stackit_argus_instance.example.id
+}
+
+resource "stackit_load_balancer_credential" "example" {
+  project_id   = var.project_id
+  display_name = "example"
+  username     = stackit_argus_credential.example.username
+  password     = stackit_argus_credential.example.password
+}
+
+# alternatively, pass the password as a write-only argument, so the load balancer credential doesn't store it in the state
+# the argus credential has to outlive the apply, use the managed resource rather than the ephemeral one,
+# which deletes the credential when it's closed
+resource "stackit_load_balancer_credential" "write_only" {
+  project_id          = var.project_id
+  display_name        = "example-write-only"
+  username            = stackit_argus_credential.example.username
+  password_wo         = stackit_argus_credential.example.password
+  password_wo_version = 1
+}
+
+# reference the credential in the load balancer
+# observability = {
+#   logs = {
+#     push_url        = stackit_argus_instance.example.logs_push_url
+#     credentials_ref = stackit_load_balancer_credential.example.credentials_ref
+#   }
+#   metrics = {
+#     push_url        = stackit_argus_instance.example.metrics_push_url
+#     credentials_ref = stackit_load_balancer_credential.example.credentials_ref
+#   }
+# }
@@ -0,0 +1,42 @@
+resource "stackit_load_balancer" "example" {
+  project_id = var.project_id
+  name       = "example"
+  target_pools = [{
+    name        = "example-target-pool"
+    target_port = 80
+    targets = [{
+      display_name = "initial"
+      ip_address   = "192.168.0.10"
+    }]
+  }]
+  listeners = [{
+    display_name = "example-listener"
+    port         = 80
+    protocol     = "PROTOCOL_TCP"
+    target_pool  = "example-target-pool"
+  }]
+  networks = [
+    { network_id = var.network_id }
+  ]
+
+  # targets are managed by stackit_load_balancer_target_pool
+  lifecycle {
+    ignore_changes = [target_pools]
+  }
+}
+
+resource "stackit_load_balancer_target_pool" "example" {
+  project_id         = var.project_id
+  load_balancer_name = stackit_load_balancer.example.name
+  name               = "example-target-pool"
+  target_port        = 80
+  targets = [
+    for i, ip in var.backend_ips : {
+      display_name = "backend-${i}"
+      ip_address   = ip
+    }
+  ]
+  session_persistence = {
+    use_source_ip_address = true
+  }
+}
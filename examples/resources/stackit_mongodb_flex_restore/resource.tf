@@ -0,0 +1,21 @@
+data "stackit_mongodb_flex_backups" "example" {
+  project_id  = var.project_id
+  instance_id = var.instance_id
+}
+
+resource "stackit_mongodb_flex_restore" "example" {
+  project_id  = var.project_id
+  instance_id = var.instance_id
+  backup_id   = data.stackit_mongodb_flex_backups.example.backups[0].id
+}
+
+# point-in-time clone of an existing instance into a new one
+resource "stackit_mongodb_flex_instance" "clone" {
+  name         = "example-clone"
+  project_id   = var.project_id
+  machine_type = "1.1"
+  clone_from = {
+    instance_id = var.instance_id
+    timestamp   = "2023-06-01T10:00:00Z"
+  }
+}
@@ -0,0 +1,24 @@
+resource "stackit_mongodb_flex_role" "example" {
+  project_id  = "example"
+  instance_id = "example"
+  name        = "reporting"
+  privileges = [
+    {
+      database   = "sales"
+      collection = "orders"
+      actions    = ["find"]
+    },
+    {
+      database = "analytics"
+      actions  = ["find", "insert"]
+    }
+  ]
+}
+
+resource "stackit_mongodb_flex_user" "example" {
+  project_id   = "example"
+  instance_id  = "example"
+  database     = "admin"
+  roles        = ["read"]
+  custom_roles = [stackit_mongodb_flex_role.example.name]
+}
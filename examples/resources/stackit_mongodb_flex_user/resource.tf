@@ -7,6 +7,11 @@ resource "stackit_mongodb_flex_instance" "example" {
 resource "stackit_mongodb_flex_user" "example" {
   project_id  = var.project_id
   instance_id = stackit_mongodb_flex_instance.example.id
+
+  # change a value to reset the password without recreating the user
+  password_rotation_trigger = {
+    rotated_at = "2023-01-01"
+  }
 }
 
 output "mongodb_username" {
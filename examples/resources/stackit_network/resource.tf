@@ -0,0 +1,11 @@
+resource "stackit_network" "example" {
+  project_id       = var.project_id
+  name             = "example"
+  nameservers      = ["8.8.8.8", "8.8.4.4"]
+  prefix_length_v6 = 64
+  nameservers_v6   = ["2001:4860:4860::8888"]
+
+  labels = {
+    env = "dev"
+  }
+}
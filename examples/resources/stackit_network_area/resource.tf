@@ -0,0 +1,13 @@
+resource "stackit_network_area" "example" {
+  organization_id  = var.organization_id
+  name             = "example"
+  network_ranges   = ["10.0.0.0/16", "10.1.0.0/16"]
+  transfer_network = "10.255.255.0/24"
+
+  default_nameservers = ["8.8.8.8"]
+
+  routes = [{
+    prefix   = "192.168.0.0/24"
+    next_hop = "10.0.0.10"
+  }]
+}
@@ -7,4 +7,12 @@ resource "stackit_postgres_flex_instance" "example" {
     "45.129.40.0/21",
     "45.135.244.0/22"
   ]
+  parameters = {
+    max_connections = "200"
+    log_statement   = "ddl"
+  }
+  extensions = ["pg_stat_statements", "postgis"]
+
+  # fail destroy and replacing changes until set to false
+  deletion_protection = true
 }
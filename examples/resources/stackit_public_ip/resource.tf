@@ -0,0 +1,4 @@
+resource "stackit_public_ip" "example" {
+  project_id           = var.project_id
+  network_interface_id = stackit_server.example.network_interfaces[0].id
+}
@@ -0,0 +1,10 @@
+resource "stackit_secrets_manager_instance" "example" {
+  project_id = var.project_id
+  name       = "example"
+}
+
+resource "stackit_secrets_manager_acl" "office" {
+  project_id  = var.project_id
+  instance_id = stackit_secrets_manager_instance.example.id
+  cidr        = "193.148.160.0/19"
+}
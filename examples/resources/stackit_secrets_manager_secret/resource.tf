@@ -0,0 +1,29 @@
+resource "stackit_secrets_manager_instance" "example" {
+  project_id = var.project_id
+  name       = "example"
+}
+
+resource "stackit_secrets_manager_user" "example" {
+  project_id    = var.project_id
+  instance_id   = stackit_secrets_manager_instance.example.id
+  description   = "terraform"
+  write_enabled = true
+}
+
+resource "stackit_secrets_manager_secret" "example" {
+  api_url     = stackit_secrets_manager_instance.example.api_url
+  instance_id = stackit_secrets_manager_instance.example.id
+  username    = stackit_secrets_manager_user.example.username
+  password    = stackit_secrets_manager_user.example.password
+  path        = "app/database"
+  cas         = true
+
+  data = {
+    username = "admin"
+    password = var.database_password
+  }
+
+  custom_metadata = {
+    owner = "platform-team"
+  }
+}
@@ -0,0 +1,5 @@
+resource "stackit_security_group" "example" {
+  project_id  = var.project_id
+  name        = "example"
+  description = "allows ssh"
+}
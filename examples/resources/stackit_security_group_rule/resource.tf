@@ -0,0 +1,14 @@
+resource "stackit_security_group" "example" {
+  project_id = var.project_id
+  name       = "example"
+}
+
+resource "stackit_security_group_rule" "ssh" {
+  project_id        = var.project_id
+  security_group_id = stackit_security_group.example.id
+  direction         = "ingress"
+  protocol          = "tcp"
+  port_range_min    = 22
+  port_range_max    = 22
+  ip_range          = "0.0.0.0/0"
+}
@@ -0,0 +1,33 @@
+resource "stackit_network" "example" {
+  project_id  = var.project_id
+  name        = "example"
+  nameservers = ["8.8.8.8"]
+}
+
+resource "stackit_key_pair" "example" {
+  name       = "example"
+  public_key = file("~/.ssh/id_ed25519.pub")
+}
+
+resource "stackit_server" "example" {
+  project_id    = var.project_id
+  name          = "example"
+  machine_type  = "g1.1"
+  networks      = [stackit_network.example.id]
+  key_pair_name = stackit_key_pair.example.name
+
+  boot_volume = {
+    size        = 32
+    source_type = "image"
+    source_id   = "4364cdb2-dacd-429b-803e-f0f7cfde1c24" // Ubuntu 22.04
+  }
+
+  user_data = <<-EOT
+    #cloud-config
+    package_update: true
+  EOT
+
+  labels = {
+    env = "dev"
+  }
+}
@@ -0,0 +1,16 @@
+resource "stackit_service_account" "example" {
+  project_id = "example"
+  name       = "ci-deployer"
+}
+
+resource "stackit_service_account_key" "example" {
+  project_id            = stackit_service_account.example.project_id
+  service_account_email = stackit_service_account.example.email
+  valid_until           = "2030-01-01T00:00:00Z"
+}
+
+# the key file can be used with `service_account_key_path` or STACKIT_SERVICE_ACCOUNT_KEY_PATH
+resource "local_sensitive_file" "key" {
+  filename = "${path.module}/key.json"
+  content  = stackit_service_account_key.example.key_json
+}
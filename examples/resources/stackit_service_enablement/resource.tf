@@ -0,0 +1,4 @@
+resource "stackit_service_enablement" "example" {
+  project_id = "example"
+  service_id = "cloud.stackit.ske"
+}
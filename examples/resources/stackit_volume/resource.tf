@@ -0,0 +1,5 @@
+resource "stackit_volume" "example" {
+  project_id = var.project_id
+  name       = "example"
+  size       = 64
+}
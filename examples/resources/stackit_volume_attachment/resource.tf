@@ -0,0 +1,11 @@
+resource "stackit_volume" "data" {
+  project_id = var.project_id
+  name       = "data"
+  size       = 64
+}
+
+resource "stackit_volume_attachment" "data" {
+  project_id = var.project_id
+  server_id  = stackit_server.example.id
+  volume_id  = stackit_volume.data.id
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/discover"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/migrate"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
@@ -15,6 +18,16 @@ var (
 )
 
 func main() {
+	// `terraform-provider-stackit migrate` generates the configuration to move to the official provider
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(migrate.Run(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
+	// `terraform-provider-stackit discover` generates the configuration of existing resources
+	if len(os.Args) > 1 && os.Args[1] == "discover" {
+		os.Exit(discover.Run(os.Args[2:], os.Stdout, os.Stderr, stackit.NewClientFromEnv))
+	}
+
 	var debug bool
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
@@ -5,10 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	client "github.com/SchwarzIT/community-stackit-go-client"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/auth"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iam"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/providerdata"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -22,6 +27,39 @@ func (p *StackitProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	config.loadEnv()
+	if err := config.loadProfile(); err != nil {
+		resp.Diagnostics.AddError("couldn't load credentials profile", err.Error())
+		return
+	}
+	if os.Getenv("TF_ACC") == "1" {
+		config.EnableTraceContext = types.BoolValue(true)
+	}
+
+	c, err := newClient(ctx, config)
+	if err != nil {
+		resp.Diagnostics.AddError("couldn't initialize client with an authentication flow", err.Error())
+		return
+	}
+	data := providerdata.New(c, iam.New(c.Client, config.Endpoints.serviceAccount()))
+	resp.DataSourceData = data
+	resp.ResourceData = data
+	resp.EphemeralResourceData = data
+}
+
+// NewClientFromEnv creates a client with the credentials set in the provider's environment variables
+// it's used by subcommands of the provider binary, which have no provider configuration
+func NewClientFromEnv(ctx context.Context) (*services.Services, error) {
+	var config providerSchema
+	config.loadEnv()
+	if err := config.loadProfile(); err != nil {
+		return nil, err
+	}
+	return newClient(ctx, config)
+}
+
+// loadEnv sets credentials that aren't configured from the environment
+func (config *providerSchema) loadEnv() {
 	// Token flow
 	if config.ServiceAccountEmail.IsUnknown() || config.ServiceAccountEmail.IsNull() {
 		config.ServiceAccountEmail = types.StringValue(os.Getenv(ServiceAccountEmail))
@@ -44,27 +82,56 @@ func (p *StackitProvider) Configure(ctx context.Context, req provider.ConfigureR
 		config.PrivateKeyPath = types.StringValue(os.Getenv(PrivateKeyPath))
 	}
 
-	if os.Getenv("TF_ACC") == "1" {
-		config.EnableTraceContext = types.BoolValue(true)
+	// OIDC flow
+	if v := os.Getenv(UseOIDC); v != "" && (config.UseOIDC.IsUnknown() || config.UseOIDC.IsNull()) {
+		use, _ := strconv.ParseBool(v)
+		config.UseOIDC = types.BoolValue(use)
+	}
+	if config.OIDCToken.IsUnknown() || config.OIDCToken.IsNull() {
+		config.OIDCToken = types.StringValue(os.Getenv(OIDCToken))
 	}
+	if config.OIDCTokenFilePath.IsUnknown() || config.OIDCTokenFilePath.IsNull() {
+		config.OIDCTokenFilePath = types.StringValue(os.Getenv(OIDCTokenFilePath))
+	}
+}
 
-	var err error
+// authFlow creates a client with one authentication method
+type authFlow struct {
+	name string
+	new  func(ctx context.Context, config providerSchema) (*services.Services, error)
+}
 
-	kfcl, err := keyFlow(ctx, config)
-	if err == nil {
-		resp.DataSourceData = kfcl
-		resp.ResourceData = kfcl
-		return
+// newClient tries the OIDC flow if it's enabled, then the key flow and falls back to the token flow
+// the errors of all flows are returned if none succeeded
+func newClient(ctx context.Context, config providerSchema) (*services.Services, error) {
+	if err := config.Endpoints.validate(); err != nil {
+		return nil, err
+	}
+	c, err := authenticate(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	if err := config.Endpoints.apply(c); err != nil {
+		return nil, err
 	}
+	return c, nil
+}
 
-	tfcl, err2 := tokenFlow(ctx, config)
-	if err2 == nil {
-		resp.DataSourceData = tfcl
-		resp.ResourceData = tfcl
-		return
+func authenticate(ctx context.Context, config providerSchema) (*services.Services, error) {
+	flows := []authFlow{{"key flow", keyFlow}, {"token flow", tokenFlow}}
+	if config.UseOIDC.ValueBool() {
+		flows = append([]authFlow{{"OIDC flow", oidcFlow}}, flows...)
 	}
 
-	resp.Diagnostics.AddError("couldn't initialize client with an authentication flow", fmt.Sprintf("key flow client auth:\n%s\n\ntoken flow client auth:\n%s", err.Error(), err2.Error()))
+	errs := make([]string, 0, len(flows))
+	for _, f := range flows {
+		c, err := f.new(ctx, config)
+		if err == nil {
+			return c, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s client auth:\n%s", f.name, err.Error()))
+	}
+	return nil, errors.New(strings.Join(errs, "\n\n"))
 }
 
 func keyFlow(ctx context.Context, config providerSchema) (*services.Services, error) {
@@ -77,6 +144,19 @@ func keyFlow(ctx context.Context, config providerSchema) (*services.Services, er
 	})
 }
 
+func oidcFlow(ctx context.Context, config providerSchema) (*services.Services, error) {
+	f, err := auth.NewFederatedFlow(ctx, auth.FederatedFlowConfig{
+		ServiceAccountEmail: config.ServiceAccountEmail.ValueString(),
+		OIDCToken:           config.OIDCToken.ValueString(),
+		OIDCTokenFilePath:   config.OIDCTokenFilePath.ValueString(),
+		EnableTraceparent:   config.EnableTraceContext.ValueBool(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return services.Init(f)
+}
+
 func tokenFlow(ctx context.Context, config providerSchema) (*services.Services, error) {
 	if config.ServiceAccountEmail.ValueString() != "" &&
 		config.ServiceAccountToken.ValueString() != "" {
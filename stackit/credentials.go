@@ -0,0 +1,144 @@
+package stackit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultCredentialsFile is read relative to the home directory if no credentials file is configured
+var defaultCredentialsFile = filepath.Join(".stackit", "credentials.yaml")
+
+const defaultProfile = "default"
+
+// profile holds the settings of a profile in the credentials file, keys match the provider attributes
+type profile struct {
+	ServiceAccountEmail   string            `yaml:"service_account_email"`
+	ServiceAccountToken   string            `yaml:"service_account_token"`
+	ServiceAccountKey     string            `yaml:"service_account_key"`
+	PrivateKey            string            `yaml:"private_key"`
+	ServiceAccountKeyPath string            `yaml:"service_account_key_path"`
+	PrivateKeyPath        string            `yaml:"private_key_path"`
+	UseOIDC               *bool             `yaml:"use_oidc"`
+	OIDCToken             string            `yaml:"oidc_token"`
+	OIDCTokenFilePath     string            `yaml:"oidc_token_file_path"`
+	Endpoints             map[string]string `yaml:"endpoints"`
+}
+
+// loadProfile sets credentials and endpoints that are neither configured nor set in the environment from a profile of the credentials file
+// the default credentials file and profile are optional, explicitly selected ones have to exist
+func (config *providerSchema) loadProfile() error {
+	path, explicitPath := stringOrEnv(config.CredentialsFile, CredentialsFile)
+	name, explicitProfile := stringOrEnv(config.Profile, Profile)
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil
+		}
+		path = filepath.Join(home, defaultCredentialsFile)
+	}
+	if name == "" {
+		name = defaultProfile
+	}
+
+	b, err := os.ReadFile(expandHome(path))
+	if errors.Is(err, os.ErrNotExist) && !explicitPath && !explicitProfile {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed reading credentials file: %w", err)
+	}
+
+	profiles := map[string]profile{}
+	if err := yaml.Unmarshal(b, &profiles); err != nil {
+		return fmt.Errorf("failed parsing credentials file %s: %w", path, err)
+	}
+	p, ok := profiles[name]
+	if !ok {
+		if !explicitProfile {
+			return nil
+		}
+		return fmt.Errorf("profile %q not found in credentials file %s", name, path)
+	}
+	return config.applyProfile(p)
+}
+
+// applyProfile sets the credentials of the profile if none are configured or set in the environment,
+// so flows never mix credentials of different sources. Endpoints are set per service if they're empty
+func (config *providerSchema) applyProfile(p profile) error {
+	if !config.hasCredentials() {
+		config.ServiceAccountEmail = types.StringValue(p.ServiceAccountEmail)
+		config.ServiceAccountToken = types.StringValue(p.ServiceAccountToken)
+		config.ServiceAccountKey = types.StringValue(p.ServiceAccountKey)
+		config.PrivateKey = types.StringValue(p.PrivateKey)
+		config.ServiceAccountKeyPath = types.StringValue(expandHome(p.ServiceAccountKeyPath))
+		config.PrivateKeyPath = types.StringValue(expandHome(p.PrivateKeyPath))
+		config.OIDCToken = types.StringValue(p.OIDCToken)
+		config.OIDCTokenFilePath = types.StringValue(expandHome(p.OIDCTokenFilePath))
+		if config.UseOIDC.IsNull() && p.UseOIDC != nil {
+			config.UseOIDC = types.BoolValue(*p.UseOIDC)
+		}
+	}
+
+	if len(p.Endpoints) == 0 {
+		return nil
+	}
+	if config.Endpoints == nil {
+		config.Endpoints = &providerEndpoints{}
+	}
+	fields := config.Endpoints.fields()
+	for service, url := range p.Endpoints {
+		f, ok := fields[service]
+		if !ok {
+			return fmt.Errorf("unknown service %q in the endpoints of the profile", service)
+		}
+		if f.ValueString() == "" {
+			*f = types.StringValue(url)
+		}
+	}
+	return nil
+}
+
+// hasCredentials reports whether any credential of any flow is configured or set in the environment
+func (config *providerSchema) hasCredentials() bool {
+	for _, v := range []types.String{
+		config.ServiceAccountEmail,
+		config.ServiceAccountToken,
+		config.ServiceAccountKey,
+		config.PrivateKey,
+		config.ServiceAccountKeyPath,
+		config.PrivateKeyPath,
+		config.OIDCToken,
+		config.OIDCTokenFilePath,
+	} {
+		if v.ValueString() != "" {
+			return true
+		}
+	}
+	return config.UseOIDC.ValueBool()
+}
+
+// stringOrEnv returns the configured value or the environment variable and whether any was set
+func stringOrEnv(v types.String, env string) (string, bool) {
+	if s := v.ValueString(); s != "" {
+		return s, true
+	}
+	s := os.Getenv(env)
+	return s, s != ""
+}
+
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[2:])
+}
@@ -0,0 +1,91 @@
+package stackit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testCredentialsFile = `
+default:
+  service_account_token: default-token
+qa:
+  service_account_email: qa@example.com
+  service_account_token: qa-token
+  use_oidc: true
+  endpoints:
+    ske: https://ske.api.qa.stackit.cloud
+    postgres_flex: http://localhost:8080
+broken:
+  endpoints:
+    dns: https://dns.api.stackit.cloud
+`
+
+func TestLoadProfile(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	path := filepath.Join(t.TempDir(), "credentials.yaml")
+	if err := os.WriteFile(path, []byte(testCredentialsFile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(CredentialsFile, "")
+	t.Setenv(Profile, "")
+
+	config := providerSchema{
+		CredentialsFile:     types.StringValue(path),
+		Profile:             types.StringValue("qa"),
+		ServiceAccountToken: types.StringValue("configured"),
+		Endpoints:           &providerEndpoints{SKE: types.StringValue("https://ske.example.com")},
+	}
+	if err := config.loadProfile(); err != nil {
+		t.Fatal(err)
+	}
+	if got := config.ServiceAccountToken.ValueString(); got != "configured" {
+		t.Errorf("ServiceAccountToken = %q, configured values should take precedence", got)
+	}
+	if got := config.ServiceAccountEmail.ValueString(); got != "" {
+		t.Errorf("ServiceAccountEmail = %q, profile credentials shouldn't be mixed with configured ones", got)
+	}
+	if config.UseOIDC.ValueBool() {
+		t.Error("UseOIDC shouldn't be read from the profile if credentials are configured")
+	}
+	if got := config.Endpoints.SKE.ValueString(); got != "https://ske.example.com" {
+		t.Errorf("SKE endpoint = %q, configured endpoints should take precedence", got)
+	}
+	if got := config.Endpoints.PostgresFlex.ValueString(); got != "http://localhost:8080" {
+		t.Errorf("PostgresFlex endpoint = %q, want the profile's endpoint", got)
+	}
+
+	// the credentials of the profile are applied as a whole if none are configured
+	config = providerSchema{CredentialsFile: types.StringValue(path), Profile: types.StringValue("qa")}
+	if err := config.loadProfile(); err != nil {
+		t.Fatal(err)
+	}
+	if config.ServiceAccountEmail.ValueString() != "qa@example.com" || config.ServiceAccountToken.ValueString() != "qa-token" || !config.UseOIDC.ValueBool() {
+		t.Errorf("expected the profile's credentials, got email %q, token %q, use_oidc %v",
+			config.ServiceAccountEmail.ValueString(), config.ServiceAccountToken.ValueString(), config.UseOIDC.ValueBool())
+	}
+
+	// the default profile is used if none is selected
+	config = providerSchema{CredentialsFile: types.StringValue(path)}
+	if err := config.loadProfile(); err != nil || config.ServiceAccountToken.ValueString() != "default-token" {
+		t.Errorf("loadProfile() = %v, token %q", err, config.ServiceAccountToken.ValueString())
+	}
+
+	for name, config := range map[string]providerSchema{
+		"missing profile": {CredentialsFile: types.StringValue(path), Profile: types.StringValue("prod")},
+		"unknown service": {CredentialsFile: types.StringValue(path), Profile: types.StringValue("broken")},
+		"missing file":    {CredentialsFile: types.StringValue(filepath.Join(t.TempDir(), "missing.yaml"))},
+	} {
+		if err := config.loadProfile(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
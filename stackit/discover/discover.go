@@ -0,0 +1,203 @@
+package discover
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const header = `# Generated by "discover": review the resources below and add their remaining required attributes,
+# "terraform plan" shows the differences to the imported resources
+`
+
+var (
+	invalidLabel = regexp.MustCompile(`[^a-z0-9_]+`)
+	validStart   = regexp.MustCompile(`^[a-z_]`)
+)
+
+// Resource is a discovered resource
+type Resource struct {
+	// Type is the resource type of this provider
+	Type string
+
+	// Name is the remote name, used to build the resource label
+	Name string
+
+	// ImportID is accepted by the ImportState of the resource type
+	ImportID string
+
+	// Arguments are written to the resource stub
+	Arguments map[string]string
+}
+
+// Result is the outcome of a discovery
+type Result struct {
+	// Config holds the `import` blocks and resource stubs
+	Config []byte
+
+	// Report lists the discovered resources and the services that failed
+	Report []string
+}
+
+// Run is the entrypoint of the `discover` subcommand and returns its exit code
+// newClient initializes the client with the same authentication flows as the provider
+func Run(args []string, stdout, stderr io.Writer, newClient func(context.Context) (*services.Services, error)) int {
+	all := Listers()
+	names := make([]string, len(all))
+	for i, l := range all {
+		names[i] = l.Service
+	}
+
+	fs := flag.NewFlagSet("discover", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	projectID := fs.String("project-id", "", "ID of the project to discover (required)")
+	out := fs.String("out", "discovered.tf", "file the generated configuration is written to")
+	only := fs.String("services", "", "comma separated services to discover, defaults to all of: "+strings.Join(names, ", "))
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s discover -project-id <id> [flags]\n\n", filepath.Base(os.Args[0]))
+		fmt.Fprint(stderr, "Generates import blocks and resource stubs for the resources of an existing project.\n")
+		fmt.Fprint(stderr, "Credentials are read from the environment variables supported by the provider.\n\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if err := validate.ProjectID(*projectID); err != nil {
+		fmt.Fprintf(stderr, "invalid -project-id: %s\n", err)
+		return 2
+	}
+	listers, err := selectListers(all, *only)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	ctx := context.Background()
+	c, err := newClient(ctx)
+	if err != nil {
+		fmt.Fprintf(stderr, "couldn't initialize client: %s\n", err)
+		return 1
+	}
+
+	res, err := Discover(ctx, c, *projectID, listers)
+	for _, line := range res.Report {
+		fmt.Fprintln(stdout, line)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	if err := os.WriteFile(*out, res.Config, 0o644); err != nil {
+		fmt.Fprintf(stderr, "failed writing %s: %s\n", *out, err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "\nconfiguration written to %s\n", *out)
+	return 0
+}
+
+// Discover lists the resources of the project and generates their configuration
+// services that fail are reported and skipped, an error is only returned if all of them failed
+func Discover(ctx context.Context, c *services.Services, projectID string, listers []Lister) (Result, error) {
+	report := []string{}
+	resources := []Resource{}
+	failed := 0
+	for _, l := range listers {
+		found, err := l.List(ctx, c, projectID)
+		if err != nil {
+			failed++
+			report = append(report, fmt.Sprintf("%s: skipped, %s", l.Service, err))
+			continue
+		}
+		report = append(report, fmt.Sprintf("%s: found %d resources", l.Service, len(found)))
+		resources = append(resources, found...)
+	}
+	if len(listers) > 0 && failed == len(listers) {
+		return Result{Report: report}, errors.New("discovery failed for all services")
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		if resources[i].Type != resources[j].Type {
+			return resources[i].Type < resources[j].Type
+		}
+		return resources[i].Name < resources[j].Name
+	})
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	body.AppendUnstructuredTokens(hclwrite.Tokens{{Type: hclsyntax.TokenComment, Bytes: []byte(header)}})
+	used := map[string]bool{}
+	for _, r := range resources {
+		name := uniqueLabel(used, r.Type, r.Name)
+		report = append(report, fmt.Sprintf("  %s.%s <- %s", r.Type, name, r.ImportID))
+
+		body.AppendNewline()
+		imp := body.AppendNewBlock("import", nil).Body()
+		imp.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: r.Type}, hcl.TraverseAttr{Name: name}})
+		imp.SetAttributeValue("id", cty.StringVal(r.ImportID))
+
+		body.AppendNewline()
+		res := body.AppendNewBlock("resource", []string{r.Type, name}).Body()
+		keys := make([]string, 0, len(r.Arguments))
+		for k := range r.Arguments {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			res.SetAttributeValue(k, cty.StringVal(r.Arguments[k]))
+		}
+	}
+
+	return Result{Config: hclwrite.Format(f.Bytes()), Report: report}, nil
+}
+
+// selectListers filters the listers by a comma separated list of services
+func selectListers(all []Lister, only string) ([]Lister, error) {
+	if only == "" {
+		return all, nil
+	}
+	byService := map[string]Lister{}
+	for _, l := range all {
+		byService[l.Service] = l
+	}
+	selected := []Lister{}
+	for _, s := range strings.Split(only, ",") {
+		l, ok := byService[strings.TrimSpace(s)]
+		if !ok {
+			return nil, fmt.Errorf("unknown service %q", strings.TrimSpace(s))
+		}
+		selected = append(selected, l)
+	}
+	return selected, nil
+}
+
+// uniqueLabel turns a remote name into a resource label that isn't used yet for the type
+func uniqueLabel(used map[string]bool, resourceType, name string) string {
+	base := strings.Trim(invalidLabel.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if !validStart.MatchString(base) {
+		base = "r_" + base
+	}
+	label := base
+	for i := 2; used[resourceType+"."+label]; i++ {
+		label = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[resourceType+"."+label] = true
+	return label
+}
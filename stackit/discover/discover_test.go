@@ -0,0 +1,91 @@
+package discover
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+func fakeLister(service string, err error, resources ...Resource) Lister {
+	return Lister{Service: service, List: func(context.Context, *services.Services, string) ([]Resource, error) {
+		return resources, err
+	}}
+}
+
+func TestDiscover(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	projectID := "8a2d2862-ac85-4084-8144-4c08c6ac3a5a"
+	stub := func(resourceType, name, id string) Resource {
+		return Resource{Type: resourceType, Name: name, ImportID: id, Arguments: map[string]string{"project_id": projectID, "name": name}}
+	}
+	listers := []Lister{
+		fakeLister("postgres-flex", nil,
+			stub("stackit_postgres_flex_instance", "My DB", projectID+",i2"),
+			stub("stackit_postgres_flex_instance", "my-db", projectID+",i1"),
+		),
+		fakeLister("object-storage", nil, stub("stackit_object_storage_bucket", "1-bucket", projectID+",1-bucket")),
+		fakeLister("argus", errors.New("forbidden")),
+	}
+
+	res, err := Discover(context.Background(), nil, projectID, listers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := string(res.Config)
+	for _, want := range []string{
+		"to = stackit_object_storage_bucket.r_1_bucket",
+		`id = "` + projectID + `,1-bucket"`,
+		`resource "stackit_object_storage_bucket" "r_1_bucket"`,
+		"to = stackit_postgres_flex_instance.my_db",
+		"to = stackit_postgres_flex_instance.my_db_2",
+		`id = "` + projectID + `,i1"`,
+		`project_id = "` + projectID + `"`,
+	} {
+		if !strings.Contains(config, want) {
+			t.Errorf("config doesn't contain %q:\n%s", want, config)
+		}
+	}
+	if strings.Index(config, "stackit_object_storage_bucket") > strings.Index(config, "stackit_postgres_flex_instance") {
+		t.Errorf("resources should be sorted by type:\n%s", config)
+	}
+
+	report := strings.Join(res.Report, "\n")
+	for _, want := range []string{"argus: skipped, forbidden", "postgres-flex: found 2 resources"} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report doesn't contain %q:\n%s", want, report)
+		}
+	}
+
+	if _, err := Discover(context.Background(), nil, projectID, listers[2:]); err == nil {
+		t.Error("expected an error if all services failed")
+	}
+}
+
+func TestSelectListers(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	all := []Lister{fakeLister("argus", nil), fakeLister("network", nil)}
+	if got, err := selectListers(all, ""); err != nil || len(got) != 2 {
+		t.Errorf("selectListers() = %v, %v", got, err)
+	}
+	if got, err := selectListers(all, "network"); err != nil || len(got) != 1 || got[0].Service != "network" {
+		t.Errorf("selectListers() = %v, %v", got, err)
+	}
+	if _, err := selectListers(all, "argus,dns"); err == nil {
+		t.Error("expected an error for an unknown service")
+	}
+}
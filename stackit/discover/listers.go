@@ -0,0 +1,265 @@
+package discover
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	dataservices "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0"
+	loadbalancerinstances "github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
+	mongodbinstance "github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/instance"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	argusinstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/argus/instance"
+	dataservicesinstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/data-services/instance"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/kubernetes/cluster"
+	loadbalancer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/load-balancer"
+	mongodbflexinstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/mongodb-flex/instance"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/network"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/bucket"
+	postgresinstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/postgres-flex/instance"
+	secretsmanagerinstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/instance"
+	"github.com/google/uuid"
+)
+
+// Lister lists the resources of a service in a project
+type Lister struct {
+	// Service is shown in the report and used to select services
+	Service string
+
+	List func(ctx context.Context, c *services.Services, projectID string) ([]Resource, error)
+}
+
+// Listers returns the listers of all supported services
+func Listers() []Lister {
+	listers := []Lister{
+		{Service: "kubernetes", List: kubernetesClusters},
+		{Service: "postgres-flex", List: postgresFlexInstances},
+		{Service: "mongodb-flex", List: mongoDBFlexInstances},
+		{Service: "object-storage", List: buckets},
+		{Service: "argus", List: argusInstances},
+		{Service: "load-balancer", List: loadBalancers},
+		{Service: "network", List: networks},
+		{Service: "secrets-manager", List: secretsManagerInstances},
+	}
+	for _, s := range []dataservicesinstance.ResourceService{
+		dataservicesinstance.ElasticSearch,
+		dataservicesinstance.LogMe,
+		dataservicesinstance.MariaDB,
+		dataservicesinstance.Opensearch,
+		dataservicesinstance.Postgres,
+		dataservicesinstance.RabbitMQ,
+		dataservicesinstance.Redis,
+	} {
+		listers = append(listers, Lister{Service: string(s), List: dataServiceInstances(s)})
+	}
+	return listers
+}
+
+// newResource builds a resource with the import identifier of the resource's ImportState
+func newResource(resourceType string, parts []common.ImportPart, name, projectID string, values ...string) (Resource, error) {
+	id, err := common.FormatImportID(parts, values...)
+	if err != nil {
+		return Resource{}, fmt.Errorf("%s %q: %w", resourceType, name, err)
+	}
+	return Resource{
+		Type:     resourceType,
+		Name:     name,
+		ImportID: id,
+		Arguments: map[string]string{
+			"project_id": projectID,
+			"name":       name,
+		},
+	}, nil
+}
+
+func kubernetesClusters(ctx context.Context, c *services.Services, projectID string) ([]Resource, error) {
+	res, err := c.Kubernetes.Cluster.List(ctx, projectID)
+	if agg := validate.Response(res, err, "JSON200.Items"); agg != nil {
+		return nil, agg
+	}
+
+	out := []Resource{}
+	for _, cl := range *res.JSON200.Items {
+		if cl.Name == nil {
+			continue
+		}
+		r, err := newResource("stackit_kubernetes_cluster", cluster.ImportParts(), *cl.Name, projectID, projectID, *cl.Name)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func postgresFlexInstances(ctx context.Context, c *services.Services, projectID string) ([]Resource, error) {
+	res, err := c.PostgresFlex.Instance.List(ctx, projectID)
+	if agg := validate.Response(res, err, "JSON200.Items"); agg != nil {
+		return nil, agg
+	}
+
+	out := []Resource{}
+	for _, i := range *res.JSON200.Items {
+		if i.Name == nil || i.ID == nil {
+			continue
+		}
+		r, err := newResource("stackit_postgres_flex_instance", postgresinstance.ImportParts(), *i.Name, projectID, projectID, *i.ID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func mongoDBFlexInstances(ctx context.Context, c *services.Services, projectID string) ([]Resource, error) {
+	res, err := c.MongoDBFlex.Instance.List(ctx, projectID, &mongodbinstance.ListParams{})
+	if agg := validate.Response(res, err, "JSON200.Items"); agg != nil {
+		return nil, agg
+	}
+
+	out := []Resource{}
+	for _, i := range *res.JSON200.Items {
+		if i.Name == nil || i.ID == nil {
+			continue
+		}
+		r, err := newResource("stackit_mongodb_flex_instance", mongodbflexinstance.ImportParts(), *i.Name, projectID, projectID, *i.ID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func dataServiceInstances(s dataservicesinstance.ResourceService) func(ctx context.Context, c *services.Services, projectID string) ([]Resource, error) {
+	return func(ctx context.Context, c *services.Services, projectID string) ([]Resource, error) {
+		client := map[dataservicesinstance.ResourceService]*dataservices.ClientWithResponses{
+			dataservicesinstance.ElasticSearch: c.ElasticSearch,
+			dataservicesinstance.LogMe:         c.LogMe,
+			dataservicesinstance.MariaDB:       c.MariaDB,
+			dataservicesinstance.Opensearch:    c.Opensearch,
+			dataservicesinstance.Postgres:      c.PostgresDB,
+			dataservicesinstance.RabbitMQ:      c.RabbitMQ,
+			dataservicesinstance.Redis:         c.Redis,
+		}[s]
+
+		res, err := client.Instances.List(ctx, projectID)
+		if agg := validate.Response(res, err, "JSON200"); agg != nil {
+			return nil, agg
+		}
+
+		out := []Resource{}
+		for _, i := range res.JSON200.Instances {
+			if i.InstanceID == nil {
+				continue
+			}
+			r, err := newResource(fmt.Sprintf("stackit_%s_instance", s), dataservicesinstance.ImportParts(), i.Name, projectID, projectID, *i.InstanceID)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, r)
+		}
+		return out, nil
+	}
+}
+
+func buckets(ctx context.Context, c *services.Services, projectID string) ([]Resource, error) {
+	res, err := c.ObjectStorage.Bucket.List(ctx, projectID)
+	if agg := validate.Response(res, err, "JSON200"); agg != nil {
+		return nil, agg
+	}
+
+	out := []Resource{}
+	for _, b := range res.JSON200.Buckets {
+		r, err := newResource("stackit_object_storage_bucket", bucket.ImportParts(), b.Name, projectID, projectID, b.Name)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func argusInstances(ctx context.Context, c *services.Services, projectID string) ([]Resource, error) {
+	res, err := c.Argus.Instances.List(ctx, projectID)
+	if agg := validate.Response(res, err, "JSON200.Instances"); agg != nil {
+		return nil, agg
+	}
+
+	out := []Resource{}
+	for _, i := range res.JSON200.Instances {
+		if i.Name == nil {
+			continue
+		}
+		r, err := newResource("stackit_argus_instance", argusinstance.ImportParts(), *i.Name, projectID, projectID, i.ID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func loadBalancers(ctx context.Context, c *services.Services, projectID string) ([]Resource, error) {
+	res, err := c.LoadBalancer.Instances.List(ctx, projectID, &loadbalancerinstances.ListParams{})
+	if agg := validate.Response(res, err, "JSON200.LoadBalancers"); agg != nil {
+		return nil, agg
+	}
+
+	out := []Resource{}
+	for _, lb := range *res.JSON200.LoadBalancers {
+		if lb.Name == nil {
+			continue
+		}
+		r, err := newResource("stackit_load_balancer", loadbalancer.ImportParts(), *lb.Name, projectID, projectID, *lb.Name)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func networks(ctx context.Context, c *services.Services, projectID string) ([]Resource, error) {
+	pid, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.IAAS.Network.V1ListNetworksInProject(ctx, pid)
+	if agg := validate.Response(res, err, "JSON200"); agg != nil {
+		return nil, agg
+	}
+
+	out := []Resource{}
+	for _, n := range res.JSON200.Items {
+		r, err := newResource("stackit_network", network.ImportParts(), n.Name, projectID, projectID, n.NetworkID.String())
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func secretsManagerInstances(ctx context.Context, c *services.Services, projectID string) ([]Resource, error) {
+	pid, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.SecretsManager.Instances.List(ctx, pid)
+	if agg := validate.Response(res, err, "JSON200"); agg != nil {
+		return nil, agg
+	}
+
+	out := []Resource{}
+	for _, i := range res.JSON200.Instances {
+		r, err := newResource("stackit_secrets_manager_instance", secretsmanagerinstance.ImportParts(), i.Name, projectID, projectID, i.ID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
@@ -0,0 +1,196 @@
+package stackit
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	argus "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0"
+	dataservices "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0"
+	iaas "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1"
+	kubernetes "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1"
+	loadbalancer "github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0"
+	mongodbflex "github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0"
+	objectstorage "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1"
+	postgresflex "github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0"
+	resourcemanagement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	secretsmanager "github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0"
+	serviceenablement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-enablement/v1"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iam"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// providerEndpoints overrides the API base URLs of services
+type providerEndpoints struct {
+	Argus              types.String `tfsdk:"argus"`
+	ElasticSearch      types.String `tfsdk:"elasticsearch"`
+	IAAS               types.String `tfsdk:"iaas"`
+	LoadBalancer       types.String `tfsdk:"load_balancer"`
+	LogMe              types.String `tfsdk:"logme"`
+	MariaDB            types.String `tfsdk:"mariadb"`
+	MongoDBFlex        types.String `tfsdk:"mongodb_flex"`
+	ObjectStorage      types.String `tfsdk:"object_storage"`
+	Opensearch         types.String `tfsdk:"opensearch"`
+	Postgres           types.String `tfsdk:"postgres"`
+	PostgresFlex       types.String `tfsdk:"postgres_flex"`
+	RabbitMQ           types.String `tfsdk:"rabbitmq"`
+	Redis              types.String `tfsdk:"redis"`
+	ResourceManagement types.String `tfsdk:"resource_management"`
+	SecretsManager     types.String `tfsdk:"secrets_manager"`
+	ServiceAccount     types.String `tfsdk:"service_account"`
+	ServiceEnablement  types.String `tfsdk:"service_enablement"`
+	SKE                types.String `tfsdk:"ske"`
+}
+
+// fields returns the endpoints by their attribute name
+func (e *providerEndpoints) fields() map[string]*types.String {
+	return map[string]*types.String{
+		"argus":               &e.Argus,
+		"elasticsearch":       &e.ElasticSearch,
+		"iaas":                &e.IAAS,
+		"load_balancer":       &e.LoadBalancer,
+		"logme":               &e.LogMe,
+		"mariadb":             &e.MariaDB,
+		"mongodb_flex":        &e.MongoDBFlex,
+		"object_storage":      &e.ObjectStorage,
+		"opensearch":          &e.Opensearch,
+		"postgres":            &e.Postgres,
+		"postgres_flex":       &e.PostgresFlex,
+		"rabbitmq":            &e.RabbitMQ,
+		"redis":               &e.Redis,
+		"resource_management": &e.ResourceManagement,
+		"secrets_manager":     &e.SecretsManager,
+		"service_account":     &e.ServiceAccount,
+		"service_enablement":  &e.ServiceEnablement,
+		"ske":                 &e.SKE,
+	}
+}
+
+// baseURLs returns the base URLs of the services by their attribute name
+func baseURLs() map[string]baseurl.BaseURL {
+	return map[string]baseurl.BaseURL{
+		"argus":               argus.BaseURLs,
+		"elasticsearch":       dataservices.GetBaseURLs(dataservices.ElasticSearch),
+		"iaas":                iaas.BaseURLs,
+		"load_balancer":       loadbalancer.BaseURLs,
+		"logme":               dataservices.GetBaseURLs(dataservices.LogMe),
+		"mariadb":             dataservices.GetBaseURLs(dataservices.MariaDB),
+		"mongodb_flex":        mongodbflex.BaseURLs,
+		"object_storage":      objectstorage.BaseURLs,
+		"opensearch":          dataservices.GetBaseURLs(dataservices.Opensearch),
+		"postgres":            dataservices.GetBaseURLs(dataservices.PostgresDB),
+		"postgres_flex":       postgresflex.BaseURLs,
+		"rabbitmq":            dataservices.GetBaseURLs(dataservices.RabbitMQ),
+		"redis":               dataservices.GetBaseURLs(dataservices.Redis),
+		"resource_management": resourcemanagement.BaseURLs,
+		"secrets_manager":     secretsmanager.BaseURLs,
+		"service_account":     iam.BaseURLs,
+		"service_enablement":  serviceenablement.BaseURLs,
+		"ske":                 kubernetes.BaseURLs,
+	}
+}
+
+func endpointsBlock() schema.SingleNestedBlock {
+	urls := baseURLs()
+	names := make([]string, 0, len(urls))
+	for name := range urls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attrs := map[string]schema.Attribute{}
+	for _, name := range names {
+		attrs[name] = schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Custom API base URL.<br />This attribute can also be loaded from `%s` environment variable instead.", urls[name].OverrideWith),
+		}
+	}
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Custom API base URLs of services, e.g. to target a different environment or a local stub. Each provider alias can use its own endpoints.",
+		Attributes:          attrs,
+	}
+}
+
+// validate checks that the configured endpoints are http or https URLs
+func (e *providerEndpoints) validate() error {
+	if e == nil {
+		return nil
+	}
+	for name, v := range e.fields() {
+		endpoint := v.ValueString()
+		if endpoint == "" {
+			continue
+		}
+		if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid endpoint `%s`: %q must be an http or https URL", name, endpoint)
+		}
+	}
+	return nil
+}
+
+// serviceAccount returns the base URL of the service account API, which isn't part of services
+func (e *providerEndpoints) serviceAccount() string {
+	if e == nil || e.ServiceAccount.ValueString() == "" {
+		return iam.BaseURLs.Get()
+	}
+	return e.ServiceAccount.ValueString()
+}
+
+// apply replaces the clients of services with a configured endpoint by clients using it as base URL
+// the clients share the authenticated base client, so each provider alias keeps its own endpoints
+func (e *providerEndpoints) apply(s *services.Services) error {
+	if e == nil {
+		return nil
+	}
+	for name, v := range e.fields() {
+		endpoint := v.ValueString()
+		if endpoint == "" {
+			continue
+		}
+
+		var err error
+		switch name {
+		case "argus":
+			s.Argus, err = argus.NewClient(endpoint, s.Client)
+		case "elasticsearch":
+			s.ElasticSearch, err = dataservices.NewClient(endpoint, s.Client)
+		case "iaas":
+			s.IAAS, err = iaas.NewClient(endpoint, s.Client)
+		case "load_balancer":
+			s.LoadBalancer, err = loadbalancer.NewClient(endpoint, s.Client)
+		case "logme":
+			s.LogMe, err = dataservices.NewClient(endpoint, s.Client)
+		case "mariadb":
+			s.MariaDB, err = dataservices.NewClient(endpoint, s.Client)
+		case "mongodb_flex":
+			s.MongoDBFlex, err = mongodbflex.NewClient(endpoint, s.Client)
+		case "object_storage":
+			s.ObjectStorage, err = objectstorage.NewClient(endpoint, s.Client)
+		case "opensearch":
+			s.Opensearch, err = dataservices.NewClient(endpoint, s.Client)
+		case "postgres":
+			s.PostgresDB, err = dataservices.NewClient(endpoint, s.Client)
+		case "postgres_flex":
+			s.PostgresFlex, err = postgresflex.NewClient(endpoint, s.Client)
+		case "rabbitmq":
+			s.RabbitMQ, err = dataservices.NewClient(endpoint, s.Client)
+		case "redis":
+			s.Redis, err = dataservices.NewClient(endpoint, s.Client)
+		case "resource_management":
+			s.ResourceManagement, err = resourcemanagement.NewClient(endpoint, s.Client)
+		case "secrets_manager":
+			s.SecretsManager, err = secretsmanager.NewClient(endpoint, s.Client)
+		case "service_enablement":
+			s.ServiceEnablement, err = serviceenablement.NewClient(endpoint, s.Client)
+		case "ske":
+			s.Kubernetes, err = kubernetes.NewClient(endpoint, s.Client)
+		}
+		if err != nil {
+			return fmt.Errorf("failed creating the %s client for endpoint %q: %w", name, endpoint, err)
+		}
+	}
+	return nil
+}
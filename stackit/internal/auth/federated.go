@@ -0,0 +1,215 @@
+// Package auth implements authentication flows that aren't provided by the community client
+// the workload identity federation flow exchanges OIDC tokens issued by CI platforms for STACKIT access tokens
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultTokenEndpoint is the STACKIT endpoint external OIDC tokens are exchanged at
+	DefaultTokenEndpoint = "https://accounts.stackit.cloud/oauth/v2/token"
+
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	// access tokens are refreshed this long before they expire, so requests of long applies don't fail
+	refreshBefore = 2 * time.Minute
+
+	// GitHub Actions exposes an endpoint to request OIDC tokens to jobs with `id-token: write` permission
+	githubRequestURL   = "ACTIONS_ID_TOKEN_REQUEST_URL"
+	githubRequestToken = "ACTIONS_ID_TOKEN_REQUEST_TOKEN"
+)
+
+// FederatedFlowConfig configures the workload identity federation flow
+type FederatedFlowConfig struct {
+	// ServiceAccountEmail is the service account the external identity is federated with
+	ServiceAccountEmail string
+
+	// OIDCToken is the external JWT, it's read from OIDCTokenFilePath if empty
+	OIDCToken string
+
+	// OIDCTokenFilePath is read on every refresh, as CI platforms may rotate the file
+	OIDCTokenFilePath string
+
+	// TokenEndpoint defaults to DefaultTokenEndpoint
+	TokenEndpoint string
+
+	// EnableTraceparent adds a `Traceparent` header to requests
+	EnableTraceparent bool
+
+	// HTTPClient defaults to http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// FederatedFlow authenticates requests with access tokens exchanged for an external OIDC token
+type FederatedFlow struct {
+	config FederatedFlowConfig
+	now    func() time.Time
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+	TokenType   string `json:"token_type"`
+}
+
+// NewFederatedFlow validates the configuration and exchanges the first token
+// so misconfigurations are reported when the provider is configured
+func NewFederatedFlow(ctx context.Context, config FederatedFlowConfig) (*FederatedFlow, error) {
+	if config.ServiceAccountEmail == "" {
+		return nil, errors.New("`service_account_email` is required")
+	}
+	if config.OIDCToken == "" && config.OIDCTokenFilePath == "" && os.Getenv(githubRequestURL) == "" {
+		return nil, errors.New("no OIDC token found, set `oidc_token` or `oidc_token_file_path`, or run in GitHub Actions with `id-token: write` permission")
+	}
+	if config.TokenEndpoint == "" {
+		config.TokenEndpoint = DefaultTokenEndpoint
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+
+	f := &FederatedFlow{config: config, now: time.Now}
+	if _, err := f.Token(ctx); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// GetServiceAccountEmail returns the email of the federated service account
+func (f *FederatedFlow) GetServiceAccountEmail() string {
+	return f.config.ServiceAccountEmail
+}
+
+// Do sends the request with a valid access token
+func (f *FederatedFlow) Do(req *http.Request) (*http.Response, error) {
+	token, err := f.Token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if f.config.EnableTraceparent {
+		req.Header.Set("Traceparent", traceparent())
+	}
+	return f.config.HTTPClient.Do(req)
+}
+
+// Token returns the current access token and exchanges a new one if it's about to expire
+func (f *FederatedFlow) Token(ctx context.Context) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.token != "" && f.now().Add(refreshBefore).Before(f.expires) {
+		return f.token, nil
+	}
+
+	assertion, err := f.oidcToken(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed reading OIDC token: %w", err)
+	}
+	res, err := f.exchange(ctx, assertion)
+	if err != nil {
+		return "", fmt.Errorf("failed exchanging OIDC token: %w", err)
+	}
+	f.token = res.AccessToken
+	f.expires = f.now().Add(time.Duration(res.ExpiresIn) * time.Second)
+	return f.token, nil
+}
+
+// oidcToken returns the external token, file paths are preferred as they're refreshed by the CI platform
+func (f *FederatedFlow) oidcToken(ctx context.Context) (string, error) {
+	if f.config.OIDCTokenFilePath != "" {
+		b, err := os.ReadFile(f.config.OIDCTokenFilePath)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(b)), nil
+	}
+	if f.config.OIDCToken != "" {
+		return f.config.OIDCToken, nil
+	}
+	return githubToken(ctx, f.config.HTTPClient)
+}
+
+func (f *FederatedFlow) exchange(ctx context.Context, assertion string) (tokenResponse, error) {
+	form := url.Values{
+		"grant_type":            {"client_credentials"},
+		"client_id":             {f.config.ServiceAccountEmail},
+		"client_assertion_type": {clientAssertionType},
+		"client_assertion":      {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.config.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return tokenResponse{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var res tokenResponse
+	if err := doJSON(f.config.HTTPClient, req, &res); err != nil {
+		return tokenResponse{}, err
+	}
+	if res.AccessToken == "" {
+		return tokenResponse{}, errors.New("response doesn't contain an access token")
+	}
+	return res, nil
+}
+
+// githubToken requests an OIDC token from GitHub Actions
+func githubToken(ctx context.Context, c *http.Client) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, os.Getenv(githubRequestURL), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+os.Getenv(githubRequestToken))
+
+	var res struct {
+		Value string `json:"value"`
+	}
+	if err := doJSON(c, req, &res); err != nil {
+		return "", err
+	}
+	if res.Value == "" {
+		return "", errors.New("GitHub Actions returned an empty token")
+	}
+	return res.Value, nil
+}
+
+func doJSON(c *http.Client, req *http.Request, v interface{}) error {
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s: %s", req.URL.Host, res.Status, strings.TrimSpace(string(b)))
+	}
+	return json.Unmarshal(b, v)
+}
+
+// traceparent returns a W3C trace context header with random trace and span IDs
+func traceparent() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("00-%s-%s-01", hex.EncodeToString(b[:16]), hex.EncodeToString(b[16:]))
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+func TestFederatedFlow(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	exchanges := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			if err := r.ParseForm(); err != nil {
+				t.Fatal(err)
+			}
+			if r.PostForm.Get("client_id") != "sa@example.com" || r.PostForm.Get("client_assertion_type") != clientAssertionType {
+				t.Errorf("unexpected form %v", r.PostForm)
+			}
+			if r.PostForm.Get("client_assertion") != "jwt" {
+				http.Error(w, "invalid assertion", http.StatusUnauthorized)
+				return
+			}
+			exchanges++
+			fmt.Fprintf(w, `{"access_token":"token-%d","expires_in":600,"token_type":"Bearer"}`, exchanges)
+		case "/api":
+			fmt.Fprint(w, r.Header.Get("Authorization"))
+		}
+	}))
+	defer srv.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("jwt\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	f, err := NewFederatedFlow(ctx, FederatedFlowConfig{
+		ServiceAccountEmail: "sa@example.com",
+		OIDCTokenFilePath:   tokenFile,
+		TokenEndpoint:       srv.URL + "/token",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// call returns the authorization header received by the API
+	call := func() string {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, srv.URL+"/api", nil)
+		res, err := f.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(body)
+	}
+
+	if got := call(); got != "Bearer token-1" {
+		t.Errorf("authorization = %q, want %q", got, "Bearer token-1")
+	}
+	if got := call(); got != "Bearer token-1" {
+		t.Errorf("authorization = %q, want the reused %q", got, "Bearer token-1")
+	}
+	if exchanges != 1 {
+		t.Errorf("token should be reused, got %d exchanges", exchanges)
+	}
+
+	// move close to the expiry, the token is refreshed
+	now := time.Now()
+	f.now = func() time.Time { return now.Add(9 * time.Minute) }
+	if got := call(); got != "Bearer token-2" {
+		t.Errorf("authorization = %q, want the refreshed %q", got, "Bearer token-2")
+	}
+	if exchanges != 2 {
+		t.Errorf("token should be refreshed once, got %d exchanges", exchanges)
+	}
+
+	if _, err := NewFederatedFlow(ctx, FederatedFlowConfig{
+		ServiceAccountEmail: "sa@example.com",
+		OIDCToken:           "invalid",
+		TokenEndpoint:       srv.URL + "/token",
+	}); err == nil {
+		t.Error("expected an error for a rejected token")
+	}
+	if _, err := NewFederatedFlow(ctx, FederatedFlowConfig{OIDCToken: "jwt"}); err == nil {
+		t.Error("expected an error without service account email")
+	}
+}
@@ -0,0 +1,95 @@
+// Package cache holds catalog data such as versions, offerings and provider options for the lifetime of a provider
+// lookups are keyed by endpoint and project, expire after a TTL and concurrent lookups of the same key share a single request
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultTTL is the time a catalog lookup is kept
+const DefaultTTL = 5 * time.Minute
+
+// Cache stores catalog lookups of a configured provider client, it's held by the client and dropped with it
+type Cache struct {
+	ttl     time.Duration
+	now     func() time.Time
+	group   singleflight.Group
+	mu      sync.RWMutex
+	entries map[string]entry
+}
+
+type entry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// New returns an empty cache
+func New(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]entry{},
+	}
+}
+
+// Key builds a cache key from an endpoint name and the IDs the lookup is scoped to
+func Key(endpoint string, ids ...string) string {
+	return strings.Join(append([]string{endpoint}, ids...), "/")
+}
+
+// Load returns the cached value of key, or calls load to fetch it
+// concurrent callers of the same key wait for the running call and share its result
+// errors aren't cached, so the next caller will try again
+func Load[T any](ctx context.Context, c *Cache, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	if v, ok := c.get(key); ok {
+		return v.(T), nil
+	}
+
+	v, err, _ := c.group.Do(key, func() (interface{}, error) {
+		// a previous flight may have completed in the meantime
+		if v, ok := c.get(key); ok {
+			return v, nil
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("loading %s", key))
+		v, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		c.entries[key] = entry{value: v, expires: c.now().Add(c.ttl)}
+		c.mu.Unlock()
+		return v, nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return v.(T), nil
+}
+
+// Forget removes key from the cache
+func (c *Cache) Forget(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+}
+
+func (c *Cache) get(key string) (interface{}, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	e, ok := c.entries[key]
+	if !ok || !c.now().Before(e.expires) {
+		return nil, false
+	}
+	return e.value, true
+}
@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+func TestLoad(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+
+	t.Run("parallel callers share a single request", func(t *testing.T) {
+		c := New(time.Minute)
+		var calls int32
+		load := func(context.Context) (string, error) {
+			atomic.AddInt32(&calls, 1)
+			time.Sleep(20 * time.Millisecond)
+			return "value", nil
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if v, err := Load(ctx, c, Key("versions", "project"), load); err != nil || v != "value" {
+					t.Errorf("Load() = %q, %v", v, err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if calls != 1 {
+			t.Errorf("expected a single request, got %d", calls)
+		}
+	})
+
+	t.Run("keys are scoped by project", func(t *testing.T) {
+		c := New(time.Minute)
+		load := func(v string) func(context.Context) (string, error) {
+			return func(context.Context) (string, error) { return v, nil }
+		}
+
+		a, _ := Load(ctx, c, Key("versions", "a"), load("a"))
+		b, _ := Load(ctx, c, Key("versions", "b"), load("b"))
+		if a != "a" || b != "b" {
+			t.Errorf("expected separate entries, got %q and %q", a, b)
+		}
+	})
+
+	t.Run("entries expire after the TTL", func(t *testing.T) {
+		now := time.Now()
+		c := New(time.Minute)
+		c.now = func() time.Time { return now }
+
+		calls := 0
+		load := func(context.Context) (int, error) {
+			calls++
+			return calls, nil
+		}
+
+		if v, _ := Load(ctx, c, "key", load); v != 1 {
+			t.Errorf("expected first value, got %d", v)
+		}
+		now = now.Add(30 * time.Second)
+		if v, _ := Load(ctx, c, "key", load); v != 1 {
+			t.Errorf("expected cached value, got %d", v)
+		}
+		now = now.Add(time.Minute)
+		if v, _ := Load(ctx, c, "key", load); v != 2 {
+			t.Errorf("expected reloaded value, got %d", v)
+		}
+	})
+
+	t.Run("errors aren't cached", func(t *testing.T) {
+		c := New(time.Minute)
+		calls := 0
+		load := func(context.Context) (string, error) {
+			calls++
+			if calls == 1 {
+				return "", errors.New("failed")
+			}
+			return "value", nil
+		}
+
+		if _, err := Load(ctx, c, "key", load); err == nil {
+			t.Error("expected an error")
+		}
+		if v, err := Load(ctx, c, "key", load); err != nil || v != "value" {
+			t.Errorf("Load() = %q, %v", v, err)
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 requests, got %d", calls)
+		}
+	})
+
+	t.Run("forget drops the entry", func(t *testing.T) {
+		c := New(time.Minute)
+		calls := 0
+		load := func(context.Context) (string, error) {
+			calls++
+			return "value", nil
+		}
+
+		_, _ = Load(ctx, c, "key", load)
+		c.Forget("key")
+		_, _ = Load(ctx, c, "key", load)
+		if calls != 2 {
+			t.Errorf("expected 2 requests, got %d", calls)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package common
+
+import (
+	"net"
+	"sort"
+)
+
+// CanonicalCIDR normalizes a CIDR, so equal networks written differently aren't recreated
+func CanonicalCIDR(cidr string) string {
+	_, n, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return cidr
+	}
+	return n.String()
+}
+
+// DiffEntries computes which keys need to be created and which of the current entries deleted
+// it's used for lists the API manages entry by entry, like ACLs or routes, and key identifies an entry
+// duplicated entries are deleted, the result is sorted by key for a deterministic order
+func DiffEntries[T any](current []T, key func(T) string, want []string) ([]string, []T) {
+	wanted := map[string]bool{}
+	for _, k := range want {
+		wanted[k] = true
+	}
+
+	create, remove := []string{}, []T{}
+	existing := map[string]bool{}
+	for _, e := range current {
+		k := key(e)
+		if !wanted[k] || existing[k] {
+			remove = append(remove, e)
+			continue
+		}
+		existing[k] = true
+	}
+	for k := range wanted {
+		if !existing[k] {
+			create = append(create, k)
+		}
+	}
+
+	sort.Strings(create)
+	sort.SliceStable(remove, func(i, j int) bool {
+		return key(remove[i]) < key(remove[j])
+	})
+	return create, remove
+}
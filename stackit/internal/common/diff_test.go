@@ -0,0 +1,75 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffEntries(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	type entry struct {
+		ID   string
+		CIDR string
+	}
+	key := func(e entry) string { return CanonicalCIDR(e.CIDR) }
+
+	tests := []struct {
+		name       string
+		current    []entry
+		want       []string
+		wantCreate []string
+		wantRemove []entry
+	}{
+		{
+			name:       "unchanged",
+			current:    []entry{{ID: "1", CIDR: "10.0.0.0/16"}},
+			want:       []string{"10.0.0.0/16"},
+			wantCreate: []string{},
+			wantRemove: []entry{},
+		},
+		{
+			name:       "add and remove",
+			current:    []entry{{ID: "1", CIDR: "10.0.0.0/16"}, {ID: "2", CIDR: "10.1.0.0/16"}},
+			want:       []string{"10.0.0.0/16", "10.2.0.0/16"},
+			wantCreate: []string{"10.2.0.0/16"},
+			wantRemove: []entry{{ID: "2", CIDR: "10.1.0.0/16"}},
+		},
+		{
+			name:       "equal networks",
+			current:    []entry{{ID: "1", CIDR: "45.129.40.1/21"}},
+			want:       []string{"45.129.40.0/21"},
+			wantCreate: []string{},
+			wantRemove: []entry{},
+		},
+		{
+			name:       "duplicates",
+			current:    []entry{{ID: "2", CIDR: "10.0.0.0/16"}, {ID: "1", CIDR: "10.0.0.0/16"}},
+			want:       []string{"10.0.0.0/16"},
+			wantCreate: []string{},
+			wantRemove: []entry{{ID: "1", CIDR: "10.0.0.0/16"}},
+		},
+		{
+			name:       "clear",
+			current:    []entry{{ID: "2", CIDR: "192.168.0.0/16"}, {ID: "1", CIDR: "10.0.0.0/8"}},
+			want:       []string{},
+			wantCreate: []string{},
+			wantRemove: []entry{{ID: "1", CIDR: "10.0.0.0/8"}, {ID: "2", CIDR: "192.168.0.0/16"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			create, remove := DiffEntries(tt.current, key, tt.want)
+			if !reflect.DeepEqual(create, tt.wantCreate) {
+				t.Errorf("DiffEntries() create = %v, want %v", create, tt.wantCreate)
+			}
+			if !reflect.DeepEqual(remove, tt.wantRemove) {
+				t.Errorf("DiffEntries() remove = %v, want %v", remove, tt.wantRemove)
+			}
+		})
+	}
+}
@@ -64,11 +64,19 @@ func GetAcceptanceTestsNetworkID() string {
 	return ""
 }
 
+// GetAcceptanceTestsOrganizationID returns the organization ID for acceptance test
+func GetAcceptanceTestsOrganizationID() string {
+	if v, ok := os.LookupEnv("ACC_TEST_ORGANIZATION_ID"); ok && v != "" {
+		return v
+	}
+	return ""
+}
+
 func EnvironmentInfo(u baseurl.BaseURL) string {
 	return fmt.Sprintf(`
 <br />
 
--> __Environment support__<small>To set a custom API base URL, set <code>%s</code> environment variable </small>
+-> __Environment support__<small>To set a custom API base URL, use the <code>endpoints</code> block of the provider or set <code>%s</code> environment variable </small>
 	`,
 		u.OverrideWith,
 	)
@@ -129,3 +137,29 @@ func Validate(d *diag.Diagnostics, res interface{}, err error, checkNullFields .
 	}
 	return agg
 }
+
+// ToLabels converts labels returned by the IaaS API to the schema model
+// non string values are formatted, as the API accepts arbitrary JSON values
+func ToLabels(in *map[string]interface{}) map[string]string {
+	if in == nil || len(*in) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(*in))
+	for k, v := range *in {
+		if s, ok := v.(string); ok {
+			out[k] = s
+			continue
+		}
+		out[k] = fmt.Sprint(v)
+	}
+	return out
+}
+
+// FromLabels converts labels of the schema model for the IaaS API
+func FromLabels(in map[string]string) *map[string]interface{} {
+	out := make(map[string]interface{}, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return &out
+}
@@ -0,0 +1,173 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ImportPart describes a part of a comma separated import identifier
+type ImportPart struct {
+	// Name of the part as shown in the expected format
+	Name string
+
+	// Attributes the part is written to during import
+	Attributes []string
+
+	// Validate is an optional check of the value
+	Validate func(string) error
+}
+
+// ImportProjectID is a project ID part written to the given attributes, or `project_id` if none are set
+func ImportProjectID(attributes ...string) ImportPart {
+	if len(attributes) == 0 {
+		attributes = []string{"project_id"}
+	}
+	return ImportPart{Name: attributes[0], Attributes: attributes, Validate: validate.ProjectID}
+}
+
+// ImportUUID is a UUID part written to the given attributes, or to the attribute called name if none are set
+func ImportUUID(name string, attributes ...string) ImportPart {
+	return ImportPart{Name: name, Attributes: defaultAttributes(name, attributes), Validate: validate.UUID}
+}
+
+// ImportString is a free text part written to the given attributes, or to the attribute called name if none are set
+func ImportString(name string, attributes ...string) ImportPart {
+	return ImportPart{Name: name, Attributes: defaultAttributes(name, attributes)}
+}
+
+// ImportIDOrName is a part that holds either the ID or the name of a resource
+// it isn't written to the state, the caller resolves names with ImportID.IsName
+func ImportIDOrName(name string) ImportPart {
+	return ImportPart{Name: name + "|name"}
+}
+
+func defaultAttributes(name string, attributes []string) []string {
+	if len(attributes) == 0 {
+		return []string{name}
+	}
+	return attributes
+}
+
+// ImportID holds the parsed parts of an import identifier
+type ImportID struct {
+	parts  []ImportPart
+	values []string
+}
+
+// ImportFormat returns the expected format of an import identifier
+func ImportFormat(parts ...ImportPart) string {
+	names := make([]string, len(parts))
+	for i, p := range parts {
+		names[i] = p.Name
+	}
+	return strings.Join(names, ",")
+}
+
+// ParseImportID splits id by comma and validates each part
+func ParseImportID(id string, parts ...ImportPart) (ImportID, error) {
+	values := strings.Split(id, ",")
+	if len(values) != len(parts) {
+		return ImportID{}, fmt.Errorf("Expected import identifier with format: `%s`\nInstead got: %q", ImportFormat(parts...), id)
+	}
+
+	for i, p := range parts {
+		values[i] = strings.TrimSpace(values[i])
+		if values[i] == "" {
+			return ImportID{}, fmt.Errorf("Expected import identifier with format: `%s`\n`%s` is empty in %q", ImportFormat(parts...), p.Name, id)
+		}
+		if p.Validate == nil {
+			continue
+		}
+		if err := p.Validate(values[i]); err != nil {
+			return ImportID{}, fmt.Errorf("Couldn't validate `%s`.\n%s", p.Name, err.Error())
+		}
+	}
+	return ImportID{parts: parts, values: values}, nil
+}
+
+// FormatImportID joins values in the order of parts to an import identifier
+// the identifier is parsed again, so it's guaranteed to be accepted by ImportState
+func FormatImportID(parts []ImportPart, values ...string) (string, error) {
+	if len(values) != len(parts) {
+		return "", fmt.Errorf("expected %d values for format `%s`, got %d", len(parts), ImportFormat(parts...), len(values))
+	}
+	id := strings.Join(values, ",")
+	if _, err := ParseImportID(id, parts...); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// Get returns the value of the part called name
+func (id ImportID) Get(name string) string {
+	for i, p := range id.parts {
+		if p.Name == name || p.Name == name+"|name" {
+			return id.values[i]
+		}
+	}
+	return ""
+}
+
+// IsName reports if the part called name holds a name rather than an ID
+func (id ImportID) IsName(name string) bool {
+	return validate.UUID(id.Get(name)) != nil
+}
+
+// IDByName returns the ID of the item called name among n items, e.g. the instances of a project listed by the API
+// item returns the name and ID of the i-th item, items without a name or ID are skipped
+// the error lists the available names if there is no such item, and the IDs of all matches if the name isn't unique
+func IDByName(kind, name string, n int, item func(i int) (name, id string)) (string, error) {
+	ids := map[string][]string{}
+	for i := 0; i < n; i++ {
+		itemName, id := item(i)
+		if itemName == "" || id == "" {
+			continue
+		}
+		ids[itemName] = append(ids[itemName], id)
+	}
+
+	if matches := ids[name]; len(matches) == 1 {
+		return matches[0], nil
+	} else if len(matches) > 1 {
+		sort.Strings(matches)
+		return "", fmt.Errorf("%s name %q is ambiguous, import by ID instead. matching IDs are:\n- %s", kind, name, strings.Join(matches, "\n- "))
+	}
+
+	names := make([]string, 0, len(ids))
+	for n := range ids {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	available := ""
+	for _, n := range names {
+		available = fmt.Sprintf("%s\n- %s", available, n)
+	}
+	if available == "" {
+		return "", fmt.Errorf("couldn't find %s %q, the project has none", kind, name)
+	}
+	return "", fmt.Errorf("couldn't find %s %q. available names are:%s", kind, name, available)
+}
+
+// ImportState parses the import identifier of req and writes its parts to the state
+// ok is false if the identifier is invalid, in which case an error is added to the diagnostics
+func ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, parts ...ImportPart) (id ImportID, ok bool) {
+	id, err := ParseImportID(req.ID, parts...)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return id, false
+	}
+
+	for i, p := range id.parts {
+		for _, a := range p.Attributes {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(a), id.values[i])...)
+		}
+	}
+	return id, !resp.Diagnostics.HasError()
+}
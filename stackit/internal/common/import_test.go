@@ -0,0 +1,135 @@
+package common
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseImportID(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	failOnBad := func(s string) error {
+		if s == "bad" {
+			return errors.New("bad value")
+		}
+		return nil
+	}
+	parts := []ImportPart{
+		{Name: "project_id", Attributes: []string{"project_id"}, Validate: failOnBad},
+		ImportString("instance_id"),
+		ImportIDOrName("id"),
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		wantErr string
+	}{
+		{name: "valid", id: "project,instance,id"},
+		{name: "spaces are trimmed", id: " project , instance , id "},
+		{name: "too few parts", id: "project,instance", wantErr: "`project_id,instance_id,id|name`"},
+		{name: "too many parts", id: "project,instance,id,extra", wantErr: "`project_id,instance_id,id|name`"},
+		{name: "empty part", id: "project,,id", wantErr: "`instance_id` is empty"},
+		{name: "invalid part", id: "bad,instance,id", wantErr: "Couldn't validate `project_id`"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := ParseImportID(tt.id, parts...)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseImportID() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseImportID() unexpected error = %v", err)
+			}
+			if id.Get("project_id") != "project" || id.Get("instance_id") != "instance" || id.Get("id") != "id" {
+				t.Errorf("unexpected parts %v", id.values)
+			}
+		})
+	}
+}
+
+func TestImportIDIsName(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	id, err := ParseImportID("project,my-instance", ImportString("project_id"), ImportIDOrName("id"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !id.IsName("id") {
+		t.Error("expected `my-instance` to be a name")
+	}
+
+	id, err = ParseImportID("project,4364cdb2-dacd-429b-803e-f0f7cfde1c24", ImportString("project_id"), ImportIDOrName("id"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.IsName("id") {
+		t.Error("expected a UUID to be an ID")
+	}
+}
+
+func TestIDByName(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	type item struct{ name, id string }
+	lookup := func(items []item, name string) (string, error) {
+		return IDByName("instance", name, len(items), func(i int) (string, string) {
+			return items[i].name, items[i].id
+		})
+	}
+
+	items := []item{{"b", "id-b"}, {"a", "id-a"}, {"", "id-unnamed"}}
+	if id, err := lookup(items, "a"); err != nil || id != "id-a" {
+		t.Errorf("IDByName() = %q, %v", id, err)
+	}
+
+	_, err := lookup(items, "c")
+	if err == nil || !strings.Contains(err.Error(), "available names are:\n- a\n- b") {
+		t.Errorf("expected sorted available names, got %v", err)
+	}
+
+	_, err = lookup(append(items, item{"a", "id-a2"}), "a")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") || !strings.Contains(err.Error(), "\n- id-a\n- id-a2") {
+		t.Errorf("expected an ambiguity error listing the IDs, got %v", err)
+	}
+
+	_, err = lookup(nil, "c")
+	if err == nil || !strings.Contains(err.Error(), "none") {
+		t.Errorf("expected an error for an empty project, got %v", err)
+	}
+}
+
+func TestFormatImportID(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	parts := []ImportPart{ImportString("project_id"), ImportIDOrName("id")}
+	if id, err := FormatImportID(parts, "project", "name"); err != nil || id != "project,name" {
+		t.Errorf("FormatImportID() = %q, %v", id, err)
+	}
+	if _, err := FormatImportID(parts, "project"); err == nil {
+		t.Error("expected an error for a missing value")
+	}
+	if _, err := FormatImportID(parts, "project", "a,b"); err == nil {
+		t.Error("expected an error for a value containing a comma")
+	}
+}
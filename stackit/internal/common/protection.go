@@ -0,0 +1,110 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtection is the name of the deletion protection attribute
+const deletionProtection = "deletion_protection"
+
+// DeletionProtection returns the `deletion_protection` attribute of stateful resources
+func DeletionProtection(kind string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("If `true`, the %s can't be destroyed or replaced. Set it to `false` and apply before destroying the %s or changing an attribute that forces replacement. Defaults to `false`", kind, kind),
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	}
+}
+
+// DeletionProtected reports if `deletion_protection` is enabled in state
+// in which case an error is added to the diagnostics and the deletion must be stopped
+func DeletionProtected(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) bool {
+	if !isProtected(ctx, state, diags) {
+		return false
+	}
+	diags.AddError("Deletion prevented by deletion protection",
+		"The resource has `deletion_protection` enabled.\nSet it to `false` and apply before destroying the resource.")
+	return true
+}
+
+// ReadDeletionProtection defaults a missing `deletion_protection` to `false` when the resource is read
+// it isn't stored remotely, so it's missing after import or in states of older versions
+func ReadDeletionProtection(v *types.Bool) {
+	if v.IsNull() {
+		*v = types.BoolValue(false)
+	}
+}
+
+// PreventProtectedReplacement adds an error for every replacement planned in resp
+// if `deletion_protection` is enabled in the prior state
+func PreventProtectedReplacement(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if len(resp.RequiresReplace) == 0 || !isProtected(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	for _, p := range resp.RequiresReplace {
+		addReplacementError(&resp.Diagnostics, p)
+	}
+}
+
+// ReplaceUnlessProtected is a plan modifier for string and object attributes
+// it requires replacement when the value changes, like the framework's RequiresReplace,
+// but fails the plan instead if `deletion_protection` is enabled in the prior state
+type ReplaceUnlessProtected struct{}
+
+var (
+	_ = planmodifier.String(ReplaceUnlessProtected{})
+	_ = planmodifier.Object(ReplaceUnlessProtected{})
+)
+
+// Description returns a plain text description of the modifier
+func (m ReplaceUnlessProtected) Description(context.Context) string {
+	return "If the value of this attribute changes, Terraform will destroy and recreate the resource, unless `deletion_protection` is enabled."
+}
+
+// MarkdownDescription returns a markdown description of the modifier
+func (m ReplaceUnlessProtected) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements planmodifier.String
+func (m ReplaceUnlessProtected) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	stringplanmodifier.RequiresReplace().PlanModifyString(ctx, req, resp)
+	if resp.RequiresReplace && isProtected(ctx, req.State, &resp.Diagnostics) {
+		addReplacementError(&resp.Diagnostics, req.Path)
+	}
+}
+
+// PlanModifyObject implements planmodifier.Object
+func (m ReplaceUnlessProtected) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	objectplanmodifier.RequiresReplace().PlanModifyObject(ctx, req, resp)
+	if resp.RequiresReplace && isProtected(ctx, req.State, &resp.Diagnostics) {
+		addReplacementError(&resp.Diagnostics, req.Path)
+	}
+}
+
+func isProtected(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) bool {
+	if state.Raw.IsNull() {
+		return false
+	}
+	var protected types.Bool
+	diags.Append(state.GetAttribute(ctx, path.Root(deletionProtection), &protected)...)
+	return protected.ValueBool()
+}
+
+func addReplacementError(diags *diag.Diagnostics, p path.Path) {
+	diags.AddAttributeError(p, "Replacement prevented by deletion protection",
+		fmt.Sprintf("Changing `%s` requires replacing the resource, which deletes it.\nSet `deletion_protection` to `false` and apply before making this change.", p))
+}
@@ -0,0 +1,134 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func protectedState(t *testing.T, protected bool) tfsdk.State {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":             schema.StringAttribute{Required: true},
+			deletionProtection: DeletionProtection("instance"),
+		},
+	}
+	state := tfsdk.State{Schema: s}
+	diags := state.Set(context.Background(), struct {
+		Name               types.String `tfsdk:"name"`
+		DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	}{types.StringValue("old"), types.BoolValue(protected)})
+	if diags.HasError() {
+		t.Fatalf("failed setting state: %v", diags)
+	}
+	return state
+}
+
+func TestDeletionProtected(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	for _, protected := range []bool{true, false} {
+		diags := diag.Diagnostics{}
+		if got := DeletionProtected(ctx, protectedState(t, protected), &diags); got != protected {
+			t.Errorf("DeletionProtected() = %v, want %v", got, protected)
+		}
+		if diags.HasError() != protected {
+			t.Errorf("DeletionProtected() errors = %v, want errors: %v", diags, protected)
+		}
+	}
+
+	diags := diag.Diagnostics{}
+	null := tfsdk.State{Schema: protectedState(t, true).Schema, Raw: tftypes.NewValue(tftypes.Object{}, nil)}
+	if DeletionProtected(ctx, null, &diags) || diags.HasError() {
+		t.Errorf("DeletionProtected() should ignore a null state, got %v", diags)
+	}
+}
+
+func TestReadDeletionProtection(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	for _, tt := range []struct{ in, want types.Bool }{
+		{types.BoolNull(), types.BoolValue(false)},
+		{types.BoolValue(true), types.BoolValue(true)},
+		{types.BoolValue(false), types.BoolValue(false)},
+	} {
+		v := tt.in
+		ReadDeletionProtection(&v)
+		if !v.Equal(tt.want) {
+			t.Errorf("ReadDeletionProtection(%v) = %v, want %v", tt.in, v, tt.want)
+		}
+	}
+}
+
+func TestReplaceUnlessProtected(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name        string
+		protected   bool
+		plan        string
+		wantReplace bool
+		wantErr     bool
+	}{
+		{name: "unchanged", protected: true, plan: "old"},
+		{name: "changed", plan: "new", wantReplace: true},
+		{name: "changed while protected", protected: true, plan: "new", wantReplace: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Path:       path.Root("name"),
+				State:      protectedState(t, tt.protected),
+				StateValue: types.StringValue("old"),
+				PlanValue:  types.StringValue(tt.plan),
+			}
+			req.Plan = tfsdk.Plan{Schema: req.State.Schema, Raw: req.State.Raw}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			ReplaceUnlessProtected{}.PlanModifyString(context.Background(), req, resp)
+			if resp.RequiresReplace != tt.wantReplace {
+				t.Errorf("RequiresReplace = %v, want %v", resp.RequiresReplace, tt.wantReplace)
+			}
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("errors = %v, want errors: %v", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPreventProtectedReplacement(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	for _, protected := range []bool{true, false} {
+		req := resource.ModifyPlanRequest{State: protectedState(t, protected)}
+		resp := &resource.ModifyPlanResponse{RequiresReplace: path.Paths{path.Root("name")}}
+		PreventProtectedReplacement(context.Background(), req, resp)
+		if resp.Diagnostics.HasError() != protected {
+			t.Errorf("protected %v: errors = %v", protected, resp.Diagnostics)
+		}
+	}
+}
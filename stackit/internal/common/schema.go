@@ -0,0 +1,399 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// recreateNotice is a resource only remark stripped from data source descriptions
+const recreateNotice = "Changing this value requires the resource to be recreated."
+
+// resourceOnly are attributes that only apply to resources and are dropped from data sources
+var resourceOnly = map[string]bool{
+	"timeouts":         true,
+	deletionProtection: true,
+}
+
+// DataSourceArguments lists the resource attributes that are arguments of a data source
+type DataSourceArguments struct {
+	// Required are used to look up the resource and keep their validators
+	Required []string
+	// Optional are additional arguments that keep their validators
+	Optional []string
+	// OptionalComputed are alternative lookup arguments that are set by the data source if they aren't configured
+	OptionalComputed []string
+	// Exclude are resource attributes the data source doesn't expose, e.g. secrets only returned on creation
+	Exclude []string
+}
+
+// DataSourceSchema derives a data source schema from a resource schema
+// all attributes that aren't arguments (including nested ones) become computed
+// plan modifiers, defaults and the `timeouts` and `deletion_protection` attributes are dropped
+// arguments that aren't resource attributes and unsupported attribute types are reported as errors
+func DataSourceSchema(rs rschema.Schema, description string, args DataSourceArguments) (dschema.Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	flags := map[string]attrFlags{}
+	for _, name := range args.Required {
+		flags[name] = attrFlags{required: true}
+	}
+	for _, name := range args.Optional {
+		flags[name] = attrFlags{optional: true}
+	}
+	for _, name := range args.OptionalComputed {
+		flags[name] = attrFlags{optional: true, computed: true}
+	}
+	exclude := map[string]bool{}
+	for _, name := range args.Exclude {
+		exclude[name] = true
+	}
+	for name := range flags {
+		if _, ok := rs.Attributes[name]; !ok {
+			diags.AddAttributeError(path.Root(name), "Couldn't derive data source schema", "the resource has no such attribute")
+		}
+	}
+	for name := range exclude {
+		if _, ok := rs.Attributes[name]; !ok {
+			diags.AddAttributeError(path.Root(name), "Couldn't derive data source schema", "the resource has no such attribute")
+		}
+	}
+
+	attrs := map[string]dschema.Attribute{}
+	for name, a := range rs.Attributes {
+		if resourceOnly[name] || exclude[name] {
+			continue
+		}
+		f, ok := flags[name]
+		if !ok {
+			f = attrFlags{computed: true}
+		}
+		v, err := toDataSourceAttribute(a, f)
+		if err != nil {
+			diags.AddAttributeError(path.Root(name), "Couldn't derive data source schema", err.Error())
+			continue
+		}
+		attrs[name] = v
+	}
+
+	return dschema.Schema{
+		MarkdownDescription: description,
+		DeprecationMessage:  rs.DeprecationMessage,
+		Attributes:          attrs,
+	}, diags
+}
+
+// MissingAttributes returns the paths of resource attributes that are missing in the data source schema
+// the `timeouts` and `deletion_protection` attributes are ignored and nested paths are separated by dots
+func MissingAttributes(rs rschema.Schema, ds dschema.Schema) []string {
+	missing := []string{}
+	dsAttrs := ds.Type().(attr.TypeWithAttributeTypes).AttributeTypes()
+	for name, t := range rs.Type().(attr.TypeWithAttributeTypes).AttributeTypes() {
+		if resourceOnly[name] {
+			continue
+		}
+		missing = append(missing, missingAttributes(name, t, dsAttrs[name])...)
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+func missingAttributes(path string, rt, dt attr.Type) []string {
+	if dt == nil {
+		return []string{path}
+	}
+	if re, ok := rt.(attr.TypeWithElementType); ok {
+		if de, ok := dt.(attr.TypeWithElementType); ok {
+			return missingAttributes(path, re.ElementType(), de.ElementType())
+		}
+		return nil
+	}
+	ro, ok := rt.(attr.TypeWithAttributeTypes)
+	if !ok {
+		return nil
+	}
+	do, ok := dt.(attr.TypeWithAttributeTypes)
+	if !ok {
+		return nil
+	}
+	missing := []string{}
+	dAttrs := do.AttributeTypes()
+	for name, t := range ro.AttributeTypes() {
+		missing = append(missing, missingAttributes(path+"."+name, t, dAttrs[name])...)
+	}
+	return missing
+}
+
+type attrFlags struct {
+	required bool
+	optional bool
+	computed bool
+}
+
+func (f attrFlags) argument() bool {
+	return f.required || f.optional
+}
+
+func dataSourceDescription(s string) string {
+	return strings.TrimSpace(strings.ReplaceAll(s, recreateNotice, ""))
+}
+
+func toDataSourceAttributes(in map[string]rschema.Attribute) (map[string]dschema.Attribute, error) {
+	out := make(map[string]dschema.Attribute, len(in))
+	for name, a := range in {
+		v, err := toDataSourceAttribute(a, attrFlags{computed: true})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		out[name] = v
+	}
+	return out, nil
+}
+
+func toDataSourceNestedObject(in rschema.NestedAttributeObject) (dschema.NestedAttributeObject, error) {
+	attrs, err := toDataSourceAttributes(in.Attributes)
+	if err != nil {
+		return dschema.NestedAttributeObject{}, err
+	}
+	return dschema.NestedAttributeObject{
+		CustomType: in.CustomType,
+		Attributes: attrs,
+	}, nil
+}
+
+// toDataSourceAttribute converts a single resource attribute
+// validators are only kept for arguments, as computed attributes can't be validated
+//
+//nolint:gocyclo
+func toDataSourceAttribute(a rschema.Attribute, f attrFlags) (dschema.Attribute, error) {
+	desc := dataSourceDescription(a.GetDescription())
+	mdDesc := dataSourceDescription(a.GetMarkdownDescription())
+	switch v := a.(type) {
+	case rschema.StringAttribute:
+		out := dschema.StringAttribute{
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	case rschema.BoolAttribute:
+		out := dschema.BoolAttribute{
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	case rschema.Int64Attribute:
+		out := dschema.Int64Attribute{
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	case rschema.Float64Attribute:
+		out := dschema.Float64Attribute{
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	case rschema.NumberAttribute:
+		out := dschema.NumberAttribute{
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	case rschema.ListAttribute:
+		out := dschema.ListAttribute{
+			ElementType:         v.ElementType,
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	case rschema.SetAttribute:
+		out := dschema.SetAttribute{
+			ElementType:         v.ElementType,
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	case rschema.MapAttribute:
+		out := dschema.MapAttribute{
+			ElementType:         v.ElementType,
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	case rschema.ObjectAttribute:
+		out := dschema.ObjectAttribute{
+			AttributeTypes:      v.AttributeTypes,
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	case rschema.ListNestedAttribute:
+		nested, err := toDataSourceNestedObject(v.NestedObject)
+		if err != nil {
+			return nil, err
+		}
+		out := dschema.ListNestedAttribute{
+			NestedObject:        nested,
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	case rschema.SetNestedAttribute:
+		nested, err := toDataSourceNestedObject(v.NestedObject)
+		if err != nil {
+			return nil, err
+		}
+		out := dschema.SetNestedAttribute{
+			NestedObject:        nested,
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	case rschema.MapNestedAttribute:
+		nested, err := toDataSourceNestedObject(v.NestedObject)
+		if err != nil {
+			return nil, err
+		}
+		out := dschema.MapNestedAttribute{
+			NestedObject:        nested,
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	case rschema.SingleNestedAttribute:
+		nested, err := toDataSourceAttributes(v.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		out := dschema.SingleNestedAttribute{
+			Attributes:          nested,
+			CustomType:          v.CustomType,
+			Description:         desc,
+			MarkdownDescription: mdDesc,
+			DeprecationMessage:  v.DeprecationMessage,
+			Sensitive:           v.Sensitive,
+			Required:            f.required,
+			Optional:            f.optional,
+			Computed:            f.computed,
+		}
+		if f.argument() {
+			out.Validators = v.Validators
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("unsupported resource attribute type %T", a)
+}
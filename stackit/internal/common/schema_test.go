@@ -0,0 +1,204 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testResourceSchema() rschema.Schema {
+	return rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"id": rschema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+			},
+			"name": rschema.StringAttribute{
+				Description: "Specifies the instance name. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": rschema.StringAttribute{
+				Description: "The project UUID.",
+				Required:    true,
+			},
+			"version": rschema.StringAttribute{
+				Description: "The version",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("1.0"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("1.0", "2.0"),
+				},
+			},
+			"password": rschema.StringAttribute{
+				Sensitive: true,
+				Computed:  true,
+			},
+			"labels": rschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"pools": rschema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: rschema.NestedAttributeObject{
+					Attributes: map[string]rschema.Attribute{
+						"name": rschema.StringAttribute{
+							Required: true,
+						},
+						"options": rschema.SingleNestedAttribute{
+							Optional: true,
+							Attributes: map[string]rschema.Attribute{
+								"size": rschema.Int64Attribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": rschema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]rschema.Attribute{
+					"create": rschema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDataSourceSchema(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ds, diags := DataSourceSchema(testResourceSchema(), "data source", DataSourceArguments{
+		Required:         []string{"name", "project_id"},
+		Optional:         []string{"labels"},
+		OptionalComputed: []string{"id"},
+		Exclude:          []string{"password"},
+	})
+	if diags.HasError() {
+		t.Fatalf("DataSourceSchema() failed: %v", diags)
+	}
+
+	if ds.MarkdownDescription != "data source" {
+		t.Errorf("unexpected description %q", ds.MarkdownDescription)
+	}
+	if _, ok := ds.Attributes["timeouts"]; ok {
+		t.Error("timeouts should be dropped")
+	}
+
+	name := ds.Attributes["name"].(dschema.StringAttribute)
+	if !name.Required || name.Computed || len(name.Validators) != 1 {
+		t.Errorf("name should be a required argument with validators: %+v", name)
+	}
+	if name.Description != "Specifies the instance name." {
+		t.Errorf("unexpected name description %q", name.Description)
+	}
+
+	labels := ds.Attributes["labels"].(dschema.MapAttribute)
+	if !labels.Optional || labels.Computed {
+		t.Errorf("labels should be an optional argument: %+v", labels)
+	}
+
+	version := ds.Attributes["version"].(dschema.StringAttribute)
+	if !version.Computed || version.Optional || len(version.Validators) != 0 {
+		t.Errorf("version should be computed without validators: %+v", version)
+	}
+
+	id := ds.Attributes["id"].(dschema.StringAttribute)
+	if !id.Optional || !id.Computed {
+		t.Errorf("id should be an optional and computed argument: %+v", id)
+	}
+
+	if _, ok := ds.Attributes["password"]; ok {
+		t.Error("password should be excluded")
+	}
+
+	pools := ds.Attributes["pools"].(dschema.ListNestedAttribute)
+	if !pools.Computed || pools.Optional {
+		t.Errorf("pools should be computed: %+v", pools)
+	}
+	if !pools.NestedObject.Attributes["name"].IsComputed() || pools.NestedObject.Attributes["name"].IsRequired() {
+		t.Error("nested attributes should be computed")
+	}
+	size := pools.NestedObject.Attributes["options"].(dschema.SingleNestedAttribute).Attributes["size"]
+	if !size.IsComputed() {
+		t.Error("deeply nested attributes should be computed")
+	}
+}
+
+func TestDataSourceSchemaUnsupportedAttribute(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	rs := testResourceSchema()
+	rs.Attributes["dynamic"] = rschema.DynamicAttribute{Optional: true}
+	ds, diags := DataSourceSchema(rs, "", DataSourceArguments{Required: []string{"name"}})
+	if !diags.HasError() {
+		t.Fatal("DataSourceSchema() should fail for unsupported attribute types")
+	}
+	if _, ok := ds.Attributes["name"]; !ok {
+		t.Error("supported attributes should still be converted")
+	}
+}
+
+func TestDataSourceSchemaUnknownArgument(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	_, diags := DataSourceSchema(testResourceSchema(), "", DataSourceArguments{
+		Required: []string{"name"},
+		Exclude:  []string{"secret"},
+	})
+	if !diags.HasError() {
+		t.Error("DataSourceSchema() should fail for attributes the resource doesn't have")
+	}
+}
+
+func TestMissingAttributes(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	rs := testResourceSchema()
+	ds, _ := DataSourceSchema(rs, "", DataSourceArguments{Required: []string{"name"}})
+	if got := MissingAttributes(rs, ds); len(got) != 0 {
+		t.Errorf("derived schema shouldn't miss attributes, got %v", got)
+	}
+
+	delete(ds.Attributes, "labels")
+	pools := ds.Attributes["pools"].(dschema.ListNestedAttribute)
+	delete(pools.NestedObject.Attributes["options"].(dschema.SingleNestedAttribute).Attributes, "size")
+
+	want := []string{"labels", "pools.options.size"}
+	if got := MissingAttributes(rs, ds); !reflect.DeepEqual(got, want) {
+		t.Errorf("MissingAttributes() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,69 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-version"
+)
+
+var (
+	// ErrVersionUnavailable is returned when the target version isn't offered by the API
+	ErrVersionUnavailable = errors.New("version is not available")
+
+	// ErrVersionDowngrade is returned when the target version is lower than the current version
+	ErrVersionDowngrade = errors.New("version downgrades are not supported")
+
+	// ErrVersionUpgradePath is returned when the target version can't be reached in a single upgrade
+	ErrVersionUpgradePath = errors.New("version can't be upgraded in place")
+)
+
+// ValidateVersionUpgrade checks if the current version can be upgraded in place to the target version
+// when stepwise is true, only the next available major version is a valid upgrade target
+func ValidateVersionUpgrade(current, target string, available []string, stepwise bool) error {
+	cv, err := version.NewVersion(current)
+	if err != nil {
+		return fmt.Errorf("failed parsing current version %q: %w", current, err)
+	}
+	tv, err := version.NewVersion(target)
+	if err != nil {
+		return fmt.Errorf("failed parsing target version %q: %w", target, err)
+	}
+
+	opts := version.Collection{}
+	found := false
+	for _, a := range available {
+		av, err := version.NewVersion(a)
+		if err != nil {
+			continue
+		}
+		opts = append(opts, av)
+		if av.Equal(tv) {
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("%w: %s (available: %v)", ErrVersionUnavailable, target, available)
+	}
+
+	if tv.LessThan(cv) {
+		return fmt.Errorf("%w: %s -> %s", ErrVersionDowngrade, current, target)
+	}
+	if !stepwise || tv.Segments()[0] == cv.Segments()[0] {
+		return nil
+	}
+
+	// find the next major version offered after the current one
+	sort.Sort(opts)
+	for _, o := range opts {
+		if o.Segments()[0] <= cv.Segments()[0] {
+			continue
+		}
+		if o.Segments()[0] == tv.Segments()[0] {
+			return nil
+		}
+		return fmt.Errorf("%w: %s -> %s, upgrade to %s first", ErrVersionUpgradePath, current, target, o.Original())
+	}
+	return nil
+}
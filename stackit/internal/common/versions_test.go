@@ -0,0 +1,41 @@
+package common
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateVersionUpgrade(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+	tests := []struct {
+		name      string
+		current   string
+		target    string
+		available []string
+		stepwise  bool
+		wantErr   error
+	}{
+		{name: "upgrade", current: "13", target: "14", available: []string{"12", "13", "14"}},
+		{name: "skip major", current: "12", target: "14", available: []string{"12", "13", "14"}},
+		{name: "downgrade", current: "14", target: "13", available: []string{"12", "13", "14"}, wantErr: ErrVersionDowngrade},
+		{name: "unavailable", current: "13", target: "16", available: []string{"12", "13", "14"}, wantErr: ErrVersionUnavailable},
+		{name: "stepwise upgrade", current: "5.0", target: "6.0", available: []string{"5.0", "6.0", "7.0"}, stepwise: true},
+		{name: "stepwise skip major", current: "5.0", target: "7.0", available: []string{"5.0", "6.0", "7.0"}, stepwise: true, wantErr: ErrVersionUpgradePath},
+		{name: "stepwise minor", current: "6.0", target: "6.1", available: []string{"6.0", "6.1", "7.0"}, stepwise: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateVersionUpgrade(tt.current, tt.target, tt.available, tt.stepwise)
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("ValidateVersionUpgrade() unexpected error = %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateVersionUpgrade() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
-	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
 	dataservices "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/providerdata"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 )
 
@@ -119,7 +119,7 @@ func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 		return
 	}
 
-	c, ok := req.ProviderData.(*services.Services)
+	c, ok := req.ProviderData.(*providerdata.Client)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -5,10 +5,9 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/data-services/credential"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -28,78 +27,32 @@ type Credential struct {
 	URI             types.String `tfsdk:"uri"`
 }
 
+// resources maps the services to their resource, the data source schema is derived from it
+var resources = map[DataSourceService]func() resource.Resource{
+	ElasticSearch: credential.NewElasticSearch,
+	LogMe:         credential.NewLogMe,
+	MariaDB:       credential.NewMariaDB,
+	Opensearch:    credential.NewOpensearch,
+	Postgres:      credential.NewPostgres,
+	Redis:         credential.NewRedis,
+	RabbitMQ:      credential.NewRabbitMQ,
+}
+
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Manages %s credentials\n%s",
+	rs := resource.SchemaResponse{}
+	resources[d.service]().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for %s credentials\n%s",
 			d.service.Display(),
 			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Specifies the resource ID",
-				Required:    true,
-			},
-
-			"project_id": schema.StringAttribute{
-				Description: "Project ID the credential belongs to",
-				Required:    true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
-
-			"instance_id": schema.StringAttribute{
-				Description: "Instance ID the credential belongs to",
-				Required:    true,
-			},
-
-			"host": schema.StringAttribute{
-				Description: "Credential host",
-				Computed:    true,
-			},
-
-			"hosts": schema.ListAttribute{
-				Description: "Credential hosts",
-				ElementType: types.StringType,
-				Computed:    true,
-			},
-
-			"database_name": schema.StringAttribute{
-				Description: "Database name",
-				Computed:    true,
-			},
-
-			"username": schema.StringAttribute{
-				Description: "Credential username",
-				Computed:    true,
-			},
-
-			"password": schema.StringAttribute{
-				Description: "Credential password",
-				Computed:    true,
-				Sensitive:   true,
-			},
-
-			"port": schema.Int64Attribute{
-				Description: "Credential port",
-				Computed:    true,
-			},
-
-			"syslog_drain_url": schema.StringAttribute{
-				Description: "Credential syslog_drain_url",
-				Computed:    true,
-			},
-
-			"route_service_url": schema.StringAttribute{
-				Description: "Credential route_service_url",
-				Computed:    true,
-			},
-
-			"uri": schema.StringAttribute{
-				Description: "The instance URI",
-				Computed:    true,
-			},
+		common.DataSourceArguments{
+			Required: []string{"id", "project_id", "instance_id"},
+			Exclude:  []string{"raw_response"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	resp.Schema = s
 }
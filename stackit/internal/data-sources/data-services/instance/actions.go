@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/offerings"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -51,9 +54,13 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 	// set found instance
 	instance := list.Instances[found]
 
-	ores, err := d.client.Offerings.List(ctx, config.ProjectID.ValueString())
-	if agg := common.Validate(&resp.Diagnostics, ores, err, "JSON200"); agg != nil {
-		resp.Diagnostics.AddError("failed to get offerings", agg.Error())
+	projectID := config.ProjectID.ValueString()
+	ores, err := cache.Load(ctx, d.cache, cache.Key(string(d.service)+"/offerings", projectID), func(ctx context.Context) (*offerings.ListResponse, error) {
+		res, err := d.client.Offerings.List(ctx, projectID)
+		return res, validate.Response(res, err, "JSON200")
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get offerings", err.Error())
 		return
 	}
 
@@ -93,7 +100,7 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 			elems = append(elems, types.StringValue(v))
 		}
 	}
-	config.ACL = types.ListValueMust(types.StringType, elems)
+	config.ACL = types.SetValueMust(types.StringType, elems)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
@@ -5,8 +5,9 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
-	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
 	dataservices "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/providerdata"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 )
 
@@ -101,6 +102,7 @@ func NewRabbitMQ() datasource.DataSource {
 // DataSource is the exported data source
 type DataSource struct {
 	client  *dataservices.ClientWithResponses
+	cache   *cache.Cache
 	service DataSourceService
 	urls    baseurl.BaseURL
 }
@@ -119,7 +121,7 @@ func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 		return
 	}
 
-	c, ok := req.ProviderData.(*services.Services)
+	c, ok := req.ProviderData.(*providerdata.Client)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -130,6 +132,7 @@ func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 		return
 	}
 
+	d.cache = c.Cache
 	switch d.service {
 	case ElasticSearch:
 		d.client = c.ElasticSearch
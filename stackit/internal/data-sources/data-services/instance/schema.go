@@ -5,10 +5,9 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/data-services/instance"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,75 +19,41 @@ type Instance struct {
 	Plan               types.String `tfsdk:"plan"`
 	PlanID             types.String `tfsdk:"plan_id"`
 	Version            types.String `tfsdk:"version"`
-	ACL                types.List   `tfsdk:"acl"`
+	ACL                types.Set    `tfsdk:"acl"`
 	DashboardURL       types.String `tfsdk:"dashboard_url"`
 	CFGUID             types.String `tfsdk:"cf_guid"`
 	CFSpaceGUID        types.String `tfsdk:"cf_space_guid"`
 	CFOrganizationGUID types.String `tfsdk:"cf_organization_guid"`
 }
 
-// Schema returns the terraform schema structure
+// resources maps the services to their resource, the data source schema is derived from it
+var resources = map[DataSourceService]func() resource.Resource{
+	ElasticSearch: instance.NewElasticSearch,
+	LogMe:         instance.NewLogMe,
+	MariaDB:       instance.NewMariaDB,
+	Opensearch:    instance.NewOpensearch,
+	Postgres:      instance.NewPostgres,
+	Redis:         instance.NewRedis,
+	RabbitMQ:      instance.NewRabbitMQ,
+}
 
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Data source for %s instances\n%s\n%s",
+	rs := resource.SchemaResponse{}
+	resources[d.service]().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for %s instances\n%s\n%s",
 			d.service.Display(),
 			printDeprecation(d.service.Display()),
 			common.EnvironmentInfo(d.urls),
 		),
-		DeprecationMessage: printDeprecation(d.service.Display()),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Specifies the resource ID",
-				Computed:    true,
-			},
-			"name": schema.StringAttribute{
-				Description: "Specifies the instance name.",
-				Required:    true,
-			},
-			"project_id": schema.StringAttribute{
-				Description: "The project ID.",
-				Required:    true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
-			"plan": schema.StringAttribute{
-				Description: "The RabbitMQ Plan",
-				Computed:    true,
-			},
-			"plan_id": schema.StringAttribute{
-				Description: "The selected plan ID",
-				Computed:    true,
-			},
-			"version": schema.StringAttribute{
-				Description: "RabbitMQ version",
-				Computed:    true,
-			},
-			"acl": schema.ListAttribute{
-				Description: "Access Control rules to whitelist IP addresses",
-				ElementType: types.StringType,
-				Computed:    true,
-			},
-			"dashboard_url": schema.StringAttribute{
-				Description: "Dashboard URL",
-				Computed:    true,
-			},
-			"cf_guid": schema.StringAttribute{
-				Description: "Cloud Foundry GUID",
-				Computed:    true,
-			},
-			"cf_space_guid": schema.StringAttribute{
-				Description: "Cloud Foundry Space GUID",
-				Computed:    true,
-			},
-			"cf_organization_guid": schema.StringAttribute{
-				Description: "Cloud Foundry Organization GUID",
-				Computed:    true,
-			},
+		common.DataSourceArguments{
+			Required: []string{"name", "project_id"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	resp.Schema = s
 }
 
 func printDeprecation(svc string) string {
@@ -5,8 +5,8 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
-	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
 	kubernetes "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/providerdata"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 )
 
@@ -19,7 +19,7 @@ func New() datasource.DataSource {
 
 // DataSource is the exported data source
 type DataSource struct {
-	client *services.Services
+	client *providerdata.Client
 	urls   baseurl.BaseURL
 }
 
@@ -37,7 +37,7 @@ func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*services.Services)
+	client, ok := req.ProviderData.(*providerdata.Client)
 
 	if !ok {
 		resp.Diagnostics.AddError(
@@ -15,6 +15,12 @@ func transform(c *Cluster, cl *cluster.Cluster) {
 	c.KubernetesVersion = types.StringValue(cl.Kubernetes.Version)
 	c.KubernetesVersionUsed = types.StringValue(cl.Kubernetes.Version)
 
+	if cl.Kubernetes.AllowPrivilegedContainers != nil {
+		c.AllowPrivilegedContainers = types.BoolValue(*cl.Kubernetes.AllowPrivilegedContainers)
+	} else {
+		c.AllowPrivilegedContainers = types.BoolValue(kubernetesCluster.DefaultAllowPrivileged)
+	}
+
 	// NetworkID is optional
 	if cl.Network != nil && cl.Network.ID != nil {
 		c.NetworkID = types.StringValue(*cl.Network.ID)
@@ -5,10 +5,9 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/kubernetes/project"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,25 +17,19 @@ type KubernetesProject struct {
 }
 
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		DeprecationMessage: "This data source is deprecated and will be removed in a future version.",
-		MarkdownDescription: fmt.Sprintf("Data source for STACKIT Kubernetes Engine (SKE) project\n%s",
+	rs := resource.SchemaResponse{}
+	project.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for STACKIT Kubernetes Engine (SKE) project\n%s",
 			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "kubernetes project ID",
-				Computed:    true,
-			},
-
-			"project_id": schema.StringAttribute{
-				Description: "the project ID that SKE will be enabled in",
-				Required:    true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
+		common.DataSourceArguments{
+			Required: []string{"project_id"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	s.DeprecationMessage = "This data source is deprecated and will be removed in a future version."
+	resp.Schema = s
 }
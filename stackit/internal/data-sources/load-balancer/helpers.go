@@ -49,6 +49,9 @@ func (i *Instance) parseOptions(ctx context.Context, lb instances.LoadBalancer,
 	// Private Network only
 	i.PrivateNetworkOnly = resToBool(lb.Options.PrivateNetworkOnly)
 
+	// Observability
+	i.Observability = parseObservability(lb.Options.Observability)
+
 	// ACL
 	if lb.Options.AccessControl == nil ||
 		lb.Options.AccessControl.AllowedSourceRanges == nil {
@@ -107,14 +110,31 @@ func (i *Instance) parseListeners(ctx context.Context, lb instances.LoadBalancer
 	listeners := []attr.Value{}
 	for _, l := range *lb.Listeners {
 		attrs := map[string]attr.Value{
-			"display_name": resToStr(l.DisplayName),
-			"port":         resToInt64(l.Port),
-			"protocol":     types.StringNull(),
-			"target_pool":  resToStr(l.TargetPool),
+			"display_name":           resToStr(l.DisplayName),
+			"port":                   resToInt64(l.Port),
+			"protocol":               types.StringNull(),
+			"target_pool":            resToStr(l.TargetPool),
+			"server_name_indicators": types.SetNull(types.StringType),
+			"idle_timeout":           types.StringNull(),
 		}
 		if l.Protocol != nil {
 			attrs["protocol"] = types.StringValue(string(*l.Protocol))
 		}
+		if l.ServerNameIndicators != nil && len(*l.ServerNameIndicators) > 0 {
+			names := []attr.Value{}
+			for _, n := range *l.ServerNameIndicators {
+				if n.Name != nil {
+					names = append(names, types.StringValue(*n.Name))
+				}
+			}
+			attrs["server_name_indicators"] = types.SetValueMust(types.StringType, names)
+		}
+		if l.TCP != nil {
+			attrs["idle_timeout"] = resToStr(l.TCP.IdleTimeout)
+		}
+		if l.UDP != nil {
+			attrs["idle_timeout"] = resToStr(l.UDP.IdleTimeout)
+		}
 		listeners = append(listeners, types.ObjectValueMust(listenerType, attrs))
 	}
 	val, d := types.SetValueFrom(
@@ -137,10 +157,11 @@ func (i *Instance) parseTargetPools(ctx context.Context, lb instances.LoadBalanc
 	targetPools := []attr.Value{}
 	for _, tp := range *lb.TargetPools {
 		attrs := map[string]attr.Value{
-			"name":         resToStr(tp.Name),
-			"target_port":  resToInt64(tp.TargetPort),
-			"targets":      types.SetNull(targetsType),
-			"health_check": types.ObjectNull(healthCheckType),
+			"name":                resToStr(tp.Name),
+			"target_port":         resToInt64(tp.TargetPort),
+			"targets":             types.SetNull(targetsType),
+			"health_check":        types.ObjectNull(healthCheckType),
+			"session_persistence": types.ObjectNull(sessionPersistenceType),
 		}
 		if tp.Targets != nil {
 			targets := []attr.Value{}
@@ -161,6 +182,11 @@ func (i *Instance) parseTargetPools(ctx context.Context, lb instances.LoadBalanc
 				"unhealthy_threshold": resToInt64(tp.ActiveHealthCheck.UnhealthyThreshold),
 			})
 		}
+		if tp.SessionPersistence != nil {
+			attrs["session_persistence"] = types.ObjectValueMust(sessionPersistenceType, map[string]attr.Value{
+				"use_source_ip_address": resToBool(tp.SessionPersistence.UseSourceIpAddress),
+			})
+		}
 		targetPools = append(targetPools, types.ObjectValueMust(targetPoolType, attrs))
 	}
 	v, d := types.SetValueFrom(
@@ -174,3 +200,27 @@ func (i *Instance) parseTargetPools(ctx context.Context, lb instances.LoadBalanc
 	}
 	i.TargetPools = v
 }
+
+func parseObservability(o *instances.LoadbalancerOptionObservability) basetypes.ObjectValue {
+	if o == nil || (o.Logs == nil && o.Metrics == nil) {
+		return types.ObjectNull(observabilityType)
+	}
+	logs := types.ObjectNull(observabilityTargetType)
+	if o.Logs != nil {
+		logs = types.ObjectValueMust(observabilityTargetType, map[string]attr.Value{
+			"push_url":        resToStr(o.Logs.PushUrl),
+			"credentials_ref": resToStr(o.Logs.CredentialsRef),
+		})
+	}
+	metrics := types.ObjectNull(observabilityTargetType)
+	if o.Metrics != nil {
+		metrics = types.ObjectValueMust(observabilityTargetType, map[string]attr.Value{
+			"push_url":        resToStr(o.Metrics.PushUrl),
+			"credentials_ref": resToStr(o.Metrics.CredentialsRef),
+		})
+	}
+	return types.ObjectValueMust(observabilityType, map[string]attr.Value{
+		"logs":    logs,
+		"metrics": metrics,
+	})
+}
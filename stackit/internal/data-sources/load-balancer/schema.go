@@ -5,9 +5,10 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	resourceLoadBalancer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/load-balancer"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -23,20 +24,25 @@ type Instance struct {
 	ACL                types.Set    `tfsdk:"acl"`
 	PrivateNetworkOnly types.Bool   `tfsdk:"private_network_only"`
 	PrivateAddress     types.String `tfsdk:"private_address"`
+	Observability      types.Object `tfsdk:"observability"`
 }
 
 type Listener struct {
-	DisplayName types.String `tfsdk:"display_name"`
-	Port        types.Int64  `tfsdk:"port"`
-	Protocol    types.String `tfsdk:"protocol"`
-	TargetPool  types.String `tfsdk:"target_pool"`
+	DisplayName          types.String `tfsdk:"display_name"`
+	Port                 types.Int64  `tfsdk:"port"`
+	Protocol             types.String `tfsdk:"protocol"`
+	TargetPool           types.String `tfsdk:"target_pool"`
+	ServerNameIndicators types.Set    `tfsdk:"server_name_indicators"`
+	IdleTimeout          types.String `tfsdk:"idle_timeout"`
 }
 
 var listenerType = map[string]attr.Type{
-	"display_name": types.StringType,
-	"port":         types.Int64Type,
-	"protocol":     types.StringType,
-	"target_pool":  types.StringType,
+	"display_name":           types.StringType,
+	"port":                   types.Int64Type,
+	"protocol":               types.StringType,
+	"target_pool":            types.StringType,
+	"server_name_indicators": types.SetType{ElemType: types.StringType},
+	"idle_timeout":           types.StringType,
 }
 
 type Network struct {
@@ -50,10 +56,11 @@ var networkType = map[string]attr.Type{
 }
 
 type TargetPool struct {
-	Name        types.String `tfsdk:"name"`
-	TargetPort  types.Int64  `tfsdk:"target_port"`
-	Targets     types.Set    `tfsdk:"targets"`
-	HealthCheck types.Object `tfsdk:"health_check"`
+	Name               types.String `tfsdk:"name"`
+	TargetPort         types.Int64  `tfsdk:"target_port"`
+	Targets            types.Set    `tfsdk:"targets"`
+	HealthCheck        types.Object `tfsdk:"health_check"`
+	SessionPersistence types.Object `tfsdk:"session_persistence"`
 }
 
 var targetPoolType = map[string]attr.Type{
@@ -63,6 +70,9 @@ var targetPoolType = map[string]attr.Type{
 	"health_check": types.ObjectType{
 		AttrTypes: healthCheckType,
 	},
+	"session_persistence": types.ObjectType{
+		AttrTypes: sessionPersistenceType,
+	},
 }
 
 type Target struct {
@@ -97,139 +107,47 @@ var healthCheckType = map[string]attr.Type{
 	"unhealthy_threshold": types.Int64Type,
 }
 
+type SessionPersistence struct {
+	UseSourceIPAddress types.Bool `tfsdk:"use_source_ip_address"`
+}
+
+var sessionPersistenceType = map[string]attr.Type{
+	"use_source_ip_address": types.BoolType,
+}
+
+type Observability struct {
+	Logs    types.Object `tfsdk:"logs"`
+	Metrics types.Object `tfsdk:"metrics"`
+}
+
+var observabilityType = map[string]attr.Type{
+	"logs":    types.ObjectType{AttrTypes: observabilityTargetType},
+	"metrics": types.ObjectType{AttrTypes: observabilityTargetType},
+}
+
+type ObservabilityTarget struct {
+	PushURL        types.String `tfsdk:"push_url"`
+	CredentialsRef types.String `tfsdk:"credentials_ref"`
+}
+
+var observabilityTargetType = map[string]attr.Type{
+	"push_url":        types.StringType,
+	"credentials_ref": types.StringType,
+}
+
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Data source for Load Balancer instances\n%s",
+	rs := resource.SchemaResponse{}
+	resourceLoadBalancer.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for Load Balancer instances\n%s",
 			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Specifies the resource ID",
-				Computed:    true,
-			},
-			"name": schema.StringAttribute{
-				Description: "Specifies the instance name. Changing this value requires the resource to be recreated.",
-				Required:    true,
-			},
-			"project_id": schema.StringAttribute{
-				Description: "The project UUID. Changing this value requires the resource to be recreated.",
-				Required:    true,
-			},
-			"external_address": schema.StringAttribute{
-				Description: "The external address of the instance.",
-				Computed:    true,
-			},
-			"listeners": schema.SetNestedAttribute{
-				Description: "The load balancers listeners.",
-				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"display_name": schema.StringAttribute{
-							Description: "The port the load balancer listens on.",
-							Computed:    true,
-						},
-						"port": schema.Int64Attribute{
-							Description: "The port the load balancer listens on [ 1 .. 65535 ].",
-							Computed:    true,
-						},
-						"protocol": schema.StringAttribute{
-							Description: "The protocol the load balancer listens on. Options: `PROTOCOL_TCP`, `PROTOCOL_UDP`, `PROTOCOL_TCP_PROXY`",
-							Computed:    true,
-						},
-						"target_pool": schema.StringAttribute{
-							Description: "The target pool name.",
-							Computed:    true,
-						},
-					},
-				},
-			},
-			"networks": schema.SetNestedAttribute{
-				Description: "The load balancers networks.",
-				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"network_id": schema.StringAttribute{
-							Description: "The network UUID.",
-							Computed:    true,
-						},
-						"role": schema.StringAttribute{
-							Description: "The network role. only `ROLE_LISTENERS_AND_TARGETS` is supported.",
-							Computed:    true,
-						},
-					},
-				},
-			},
-			"target_pools": schema.SetNestedAttribute{
-				Description: "The load balancers target pools.",
-				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Description: "The target pool name.",
-							Computed:    true,
-						},
-						"target_port": schema.Int64Attribute{
-							Description: "The target port.",
-							Computed:    true,
-						},
-						"targets": schema.SetNestedAttribute{
-							Description: "The target pool targets.",
-							Computed:    true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"display_name": schema.StringAttribute{
-										Description: "The target display name.",
-										Computed:    true,
-									},
-									"ip_address": schema.StringAttribute{
-										Description: "The target IP address.",
-										Computed:    true,
-									},
-								},
-							},
-						},
-						"health_check": schema.SingleNestedAttribute{
-							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"healthy_threshold": schema.Int64Attribute{
-									Description: "The healthy threshold.",
-									Computed:    true,
-								},
-								"interval": schema.StringAttribute{
-									Description: "The interval.",
-									Computed:    true,
-								},
-								"interval_jitter": schema.StringAttribute{
-									Description: "The interval jitter.",
-									Computed:    true,
-								},
-								"timeout": schema.StringAttribute{
-									Description: "The timeout.",
-									Computed:    true,
-								},
-								"unhealthy_threshold": schema.Int64Attribute{
-									Description: "The unhealthy threshold.",
-									Computed:    true,
-								},
-							},
-						},
-					},
-				},
-			},
-			"acl": schema.SetAttribute{
-				Description: "The load balancers ACLs.",
-				Computed:    true,
-				ElementType: types.StringType,
-			},
-			"private_network_only": schema.BoolAttribute{
-				Description: "Whether the load balancer is only accessible via private networks.",
-				Computed:    true,
-			},
-			"private_address": schema.StringAttribute{
-				Description: "The private address of the load balancer.",
-				Computed:    true,
-			},
+		common.DataSourceArguments{
+			Required: []string{"name", "project_id"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	resp.Schema = s
 }
@@ -0,0 +1,63 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Read - lifecycle function
+func (r DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	c := r.client.MongoDBFlex
+	var config Backups
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := c.Backup.List(ctx, config.ProjectID.ValueString(), config.InstanceID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		resp.Diagnostics.AddError("failed to list mongodb flex backups", agg.Error())
+		return
+	}
+
+	config.Backups = []Backup{}
+	if res.JSON200.Items != nil {
+		for _, b := range *res.JSON200.Items {
+			config.Backups = append(config.Backups, Backup{
+				ID:        nullOrValStr(b.ID),
+				Name:      nullOrValStr(b.Name),
+				Size:      nullOrValInt64(b.Size),
+				StartTime: nullOrValStr(b.StartTime),
+				EndTime:   nullOrValStr(b.EndTime),
+			})
+		}
+	}
+	sort.SliceStable(config.Backups, func(i, j int) bool {
+		return config.Backups[i].StartTime.ValueString() < config.Backups[j].StartTime.ValueString()
+	})
+
+	config.ID = types.StringValue(fmt.Sprintf("%s,%s", config.ProjectID.ValueString(), config.InstanceID.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func nullOrValStr(v *string) basetypes.StringValue {
+	a := types.StringNull()
+	if v != nil {
+		a = types.StringValue(*v)
+	}
+	return a
+}
+
+func nullOrValInt64(v *int) basetypes.Int64Value {
+	a := types.Int64Null()
+	if v != nil {
+		a = types.Int64Value(int64(*v))
+	}
+	return a
+}
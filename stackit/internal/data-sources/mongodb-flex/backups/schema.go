@@ -0,0 +1,84 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Backups is the schema model
+type Backups struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectID  types.String `tfsdk:"project_id"`
+	InstanceID types.String `tfsdk:"instance_id"`
+	Backups    []Backup     `tfsdk:"backups"`
+}
+
+// Backup represents a single instance backup
+type Backup struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Size      types.Int64  `tfsdk:"size"`
+	StartTime types.String `tfsdk:"start_time"`
+	EndTime   types.String `tfsdk:"end_time"`
+}
+
+// Schema returns the terraform schema structure
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Data source for listing the backups of a MongoDB Flex instance\n%s",
+			common.EnvironmentInfo(d.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project ID the instance runs in.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "the mongo db flex instance id.",
+				Required:    true,
+			},
+			"backups": schema.ListNestedAttribute{
+				Description: "The list of backups, ordered by start time",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Specifies the backup ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Specifies the backup name",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "Specifies the backup size in bytes",
+							Computed:    true,
+						},
+						"start_time": schema.StringAttribute{
+							Description: "Specifies when the backup started (RFC3339)",
+							Computed:    true,
+						},
+						"end_time": schema.StringAttribute{
+							Description: "Specifies when the backup ended (RFC3339)",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,78 @@
+package flavors
+
+import (
+	"context"
+	"sort"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/flavors"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Read - lifecycle function
+func (r DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Flavors
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := config.ProjectID.ValueString()
+	res, err := cache.Load(ctx, r.client.Cache, cache.Key("mongodb-flex/flavors", projectID), func(ctx context.Context) (*flavors.ListResponse, error) {
+		res, err := r.client.MongoDBFlex.Flavors.List(ctx, projectID)
+		return res, validate.Response(res, err, "JSON200.Flavors")
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list MongoDB Flex flavors", err.Error())
+		return
+	}
+
+	config.Flavors = []Flavor{}
+	for _, v := range *res.JSON200.Flavors {
+		if v.ID == nil {
+			continue
+		}
+		categories := []attr.Value{}
+		if v.Categories != nil {
+			for _, c := range *v.Categories {
+				categories = append(categories, types.StringValue(c))
+			}
+		}
+		config.Flavors = append(config.Flavors, Flavor{
+			ID:          types.StringValue(*v.ID),
+			CPU:         nullOrValInt64(v.CPU),
+			Memory:      nullOrValInt64(v.Memory),
+			Description: nullOrValStr(v.Description),
+			Categories:  types.ListValueMust(types.StringType, categories),
+		})
+	}
+	sort.SliceStable(config.Flavors, func(i, j int) bool {
+		if config.Flavors[i].CPU.ValueInt64() != config.Flavors[j].CPU.ValueInt64() {
+			return config.Flavors[i].CPU.ValueInt64() < config.Flavors[j].CPU.ValueInt64()
+		}
+		return config.Flavors[i].Memory.ValueInt64() < config.Flavors[j].Memory.ValueInt64()
+	})
+
+	config.ID = config.ProjectID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func nullOrValStr(v *string) basetypes.StringValue {
+	a := types.StringNull()
+	if v != nil {
+		a = types.StringValue(*v)
+	}
+	return a
+}
+
+func nullOrValInt64(v *int) basetypes.Int64Value {
+	a := types.Int64Null()
+	if v != nil {
+		a = types.Int64Value(int64(*v))
+	}
+	return a
+}
@@ -0,0 +1,80 @@
+package flavors
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Flavors is the schema model
+type Flavors struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Flavors   []Flavor     `tfsdk:"flavors"`
+}
+
+// Flavor represents a single machine type
+type Flavor struct {
+	ID          types.String `tfsdk:"id"`
+	CPU         types.Int64  `tfsdk:"cpu"`
+	Memory      types.Int64  `tfsdk:"memory"`
+	Description types.String `tfsdk:"description"`
+	Categories  types.List   `tfsdk:"categories"`
+}
+
+// Schema returns the terraform schema structure
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Data source for listing the available MongoDB Flex machine types (flavors)\n%s",
+			common.EnvironmentInfo(d.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+			},
+			"flavors": schema.ListNestedAttribute{
+				Description: "The list of available flavors, ordered by CPU and memory",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Specifies the flavor ID, used as `machine_type`",
+							Computed:    true,
+						},
+						"cpu": schema.Int64Attribute{
+							Description: "Specifies the number of CPUs",
+							Computed:    true,
+						},
+						"memory": schema.Int64Attribute{
+							Description: "Specifies the memory in GB",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Specifies the flavor description",
+							Computed:    true,
+						},
+						"categories": schema.ListAttribute{
+							Description: "Specifies the service types the flavor can be used with",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -92,7 +92,7 @@ func ApplyClientResponse(pi *Instance, i *instance.InstancesSingleInstance) erro
 		return errors.New("received a nil ID")
 	}
 	pi.ID = types.StringValue(*i.ID)
-	pi.ACL = types.ListValueMust(types.StringType, elems)
+	pi.ACL = types.SetValueMust(types.StringType, elems)
 	pi.BackupSchedule = nullOrValStr(i.BackupSchedule)
 	pi.MachineType = nullOrValStr(i.Flavor.ID)
 	pi.Name = nullOrValStr(i.Name)
@@ -115,6 +115,12 @@ func ApplyClientResponse(pi *Instance, i *instance.InstancesSingleInstance) erro
 		v := pi.Version.ValueString()
 		pi.Version = types.StringValue(v[0:3])
 	}
+	pi.Type = types.StringNull()
+	if i.Options != nil {
+		if v, ok := (*i.Options)["type"]; ok {
+			pi.Type = types.StringValue(v)
+		}
+	}
 	return nil
 }
 
@@ -5,10 +5,9 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	mongodbinstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/mongodb-flex/instance"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,71 +16,29 @@ type Instance struct {
 	ID             types.String `tfsdk:"id"`
 	Name           types.String `tfsdk:"name"`
 	ProjectID      types.String `tfsdk:"project_id"`
+	Type           types.String `tfsdk:"type"`
 	MachineType    types.String `tfsdk:"machine_type"` // aka FlavorID
 	Version        types.String `tfsdk:"version"`
 	Replicas       types.Int64  `tfsdk:"replicas"`
 	BackupSchedule types.String `tfsdk:"backup_schedule"`
-	ACL            types.List   `tfsdk:"acl"`
+	ACL            types.Set    `tfsdk:"acl"`
 	Storage        types.Object `tfsdk:"storage"`
 }
 
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Data source for MongoDB Flex instance\n%s",
+	rs := resource.SchemaResponse{}
+	mongodbinstance.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for MongoDB Flex instance\n%s",
 			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Specifies the resource ID.",
-				Computed:    true,
-			},
-			"name": schema.StringAttribute{
-				Description: "Specifies the instance name.",
-				Required:    true,
-			},
-			"project_id": schema.StringAttribute{
-				Description: "The project ID the instance runs in.",
-				Required:    true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
-			"machine_type": schema.StringAttribute{
-				Description: "The Machine Type.",
-				Computed:    true,
-			},
-			"version": schema.StringAttribute{
-				Description: "MongoDB version.",
-				Computed:    true,
-			},
-			"replicas": schema.Int64Attribute{
-				Description: "Number of replicas.",
-				Computed:    true,
-			},
-			"backup_schedule": schema.StringAttribute{
-				Description: "Specifies the backup schedule (cron style).",
-				Computed:    true,
-			},
-			"storage": schema.SingleNestedAttribute{
-				Description: "A single `storage` block as defined below.",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"class": schema.StringAttribute{
-						Description: "Specifies the storage class. Available option: `premium-perf2-mongodb` `premium-perf4-mongodb` `premium-perf6-mongodb` `premium-perf8-mongodb` `premium-perf10-mongodb` `premium-perf12-mongodb`",
-						Computed:    true,
-					},
-					"size": schema.Int64Attribute{
-						Description: "The storage size in GB.",
-						Computed:    true,
-					},
-				},
-			},
-			"acl": schema.ListAttribute{
-				Description: "Access Control rules to whitelist IP addresses.",
-				ElementType: types.StringType,
-				Computed:    true,
-			},
+		common.DataSourceArguments{
+			Required: []string{"name", "project_id"},
+			Exclude:  []string{"allow_replace_on_version_change", "clone_from", "labels"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	resp.Schema = s
 }
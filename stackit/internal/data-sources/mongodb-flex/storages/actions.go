@@ -0,0 +1,52 @@
+package storages
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/flavors"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Read - lifecycle function
+func (r DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Storages
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, machineType := config.ProjectID.ValueString(), config.MachineType.ValueString()
+	res, err := cache.Load(ctx, r.client.Cache, cache.Key("mongodb-flex/storages", projectID, machineType), func(ctx context.Context) (*flavors.GetStorageOptionsResponse, error) {
+		res, err := r.client.MongoDBFlex.Flavors.GetStorageOptions(ctx, projectID, machineType)
+		return res, validate.Response(res, err, "JSON200.StorageClasses")
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list MongoDB Flex storage options", err.Error())
+		return
+	}
+
+	classes := []attr.Value{}
+	for _, v := range *res.JSON200.StorageClasses {
+		classes = append(classes, types.StringValue(v))
+	}
+	config.Classes = types.ListValueMust(types.StringType, classes)
+
+	config.MinSize = types.Int64Null()
+	config.MaxSize = types.Int64Null()
+	if sr := res.JSON200.StorageRange; sr != nil {
+		if sr.Min != nil {
+			config.MinSize = types.Int64Value(int64(*sr.Min))
+		}
+		if sr.Max != nil {
+			config.MaxSize = types.Int64Value(int64(*sr.Max))
+		}
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%s,%s", config.ProjectID.ValueString(), config.MachineType.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
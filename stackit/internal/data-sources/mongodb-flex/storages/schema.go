@@ -0,0 +1,62 @@
+package storages
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Storages is the schema model
+type Storages struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	MachineType types.String `tfsdk:"machine_type"`
+	Classes     types.List   `tfsdk:"classes"`
+	MinSize     types.Int64  `tfsdk:"min_size"`
+	MaxSize     types.Int64  `tfsdk:"max_size"`
+}
+
+// Schema returns the terraform schema structure
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Data source for listing the available MongoDB Flex storage options of a machine type\n%s",
+			common.EnvironmentInfo(d.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+			},
+			"machine_type": schema.StringAttribute{
+				Description: "The machine type (flavor ID) to list the storage options for",
+				Required:    true,
+			},
+			"classes": schema.ListAttribute{
+				Description: "The available storage classes",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"min_size": schema.Int64Attribute{
+				Description: "The minimal storage size in GB",
+				Computed:    true,
+			},
+			"max_size": schema.Int64Attribute{
+				Description: "The maximal storage size in GB",
+				Computed:    true,
+			},
+		},
+	}
+}
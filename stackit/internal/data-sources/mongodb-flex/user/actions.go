@@ -4,7 +4,7 @@ import (
 	"context"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	mongodbuser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/mongodb-flex/user"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -32,13 +32,13 @@ func (r DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *
 	config.Host = nullOrValStr(item.Host)
 	config.Port = nullOrValInt64(item.Port)
 	config.Database = nullOrValStr(item.Database)
-	roles := []attr.Value{}
-	if r := item.Roles; r != nil {
-		for _, v := range *r {
-			roles = append(roles, types.StringValue(v))
-		}
+	roles := []string{}
+	if item.Roles != nil {
+		roles = *item.Roles
 	}
-	config.Roles = types.ListValueMust(types.StringType, roles)
+	builtin, custom := mongodbuser.SplitRoles(roles)
+	config.Roles = types.ListValueMust(types.StringType, builtin)
+	config.CustomRoles = types.SetValueMust(types.StringType, custom)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -63,11 +63,37 @@ func (d DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *
 	}
 
 	config.ID = types.StringValue(network.NetworkID.String())
+	config.NetworkID = config.ID
 	config.ProjectID = types.StringValue(projectID.String())
 	config.Name = types.StringValue(network.Name)
 	config.PublicIp = types.StringPointerValue(network.PublicIp)
 	config.Prefixes = types.ListValueMust(types.StringType, prefixes)
-	config.NameServers = types.ListValueMust(types.StringType, nameservers)
+	config.NameServers = types.SetValueMust(types.StringType, nameservers)
+	config.GatewayV4 = types.StringPointerValue(network.Gateway)
+	config.GatewayV6 = types.StringPointerValue(network.GatewayV6)
+	config.NoGatewayV4 = types.BoolValue(network.Gateway == nil)
+	config.NoGatewayV6 = types.BoolValue(network.PrefixesV6 != nil && len(*network.PrefixesV6) > 0 && network.GatewayV6 == nil)
+	config.Routed = types.BoolValue(network.Routed != nil && *network.Routed)
+	config.Labels = common.ToLabels(network.Labels)
+
+	prefixesV6 := make([]attr.Value, 0)
+	if network.PrefixesV6 != nil {
+		for _, pr := range *network.PrefixesV6 {
+			prefixesV6 = append(prefixesV6, types.StringValue(pr))
+		}
+	}
+	config.PrefixesV6 = types.ListValueMust(types.StringType, prefixesV6)
+
+	nameserversV6 := make([]attr.Value, 0)
+	if network.NameserversV6 != nil {
+		nsData := *network.NameserversV6
+		sort.Strings(nsData)
+
+		for _, ns := range nsData {
+			nameserversV6 = append(nameserversV6, types.StringValue(ns))
+		}
+	}
+	config.NameServersV6 = types.SetValueMust(types.StringType, nameserversV6)
 
 	// get the Prefix Length in a hacky way, otherwise fall back to default
 	if network.Prefixes != nil && len(*network.Prefixes) > 0 {
@@ -91,6 +117,24 @@ func (d DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *
 		config.PrefixLengthV4 = types.Int64Value(25)
 	}
 
+	config.PrefixLengthV6 = types.Int64Null()
+	if network.PrefixesV6 != nil && len(*network.PrefixesV6) > 0 {
+		cidrSplit := strings.Split((*network.PrefixesV6)[0], "/")
+		if len(cidrSplit) != 2 {
+			resp.Diagnostics.AddError("Processing CIDR Prefix Length",
+				"Processing CIDR Prefix Length")
+			return
+		}
+
+		prefixLength, err := strconv.ParseInt(cidrSplit[1], 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError("Processing CIDR Prefix Length", err.Error())
+			return
+		}
+
+		config.PrefixLengthV6 = types.Int64Value(prefixLength)
+	}
+
 	diags = resp.State.Set(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
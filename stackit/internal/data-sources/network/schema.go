@@ -5,70 +5,52 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/network"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Network is the schema model
 type Network struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	NameServers    types.List   `tfsdk:"nameservers"`
-	NetworkID      types.String `tfsdk:"network_id"`
-	Prefixes       types.List   `tfsdk:"prefixes"`
-	PrefixLengthV4 types.Int64  `tfsdk:"prefix_length_v4"`
-	PublicIp       types.String `tfsdk:"public_ip"`
-	ProjectID      types.String `tfsdk:"project_id"`
+	ID             types.String      `tfsdk:"id"`
+	Name           types.String      `tfsdk:"name"`
+	NameServers    types.Set         `tfsdk:"nameservers"`
+	NetworkID      types.String      `tfsdk:"network_id"`
+	Prefixes       types.List        `tfsdk:"prefixes"`
+	PrefixLengthV4 types.Int64       `tfsdk:"prefix_length_v4"`
+	GatewayV4      types.String      `tfsdk:"gateway_v4"`
+	NoGatewayV4    types.Bool        `tfsdk:"no_gateway_v4"`
+	NameServersV6  types.Set         `tfsdk:"nameservers_v6"`
+	PrefixesV6     types.List        `tfsdk:"prefixes_v6"`
+	PrefixLengthV6 types.Int64       `tfsdk:"prefix_length_v6"`
+	GatewayV6      types.String      `tfsdk:"gateway_v6"`
+	NoGatewayV6    types.Bool        `tfsdk:"no_gateway_v6"`
+	Routed         types.Bool        `tfsdk:"routed"`
+	PublicIp       types.String      `tfsdk:"public_ip"`
+	Labels         map[string]string `tfsdk:"labels"`
+	ProjectID      types.String      `tfsdk:"project_id"`
 }
 
-// Schema returns terraform schema structure
-func (r *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Data source for STACKIT network\n%s",
-			common.EnvironmentInfo(r.urls),
+// Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	rs := resource.SchemaResponse{}
+	network.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for STACKIT network\n%s",
+			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Specifies the Network ID.",
-				Required:    true,
-			},
-			"project_id": schema.StringAttribute{
-				Description: "The project UUID.",
-				Required:    true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
-
-			// read only
-			"name": schema.StringAttribute{
-				Description: "the name of the network",
-				Computed:    true,
-			},
-			"nameservers": schema.ListAttribute{
-				Description: "List of DNS Servers/Nameservers.",
-				ElementType: types.StringType,
-				Computed:    true,
-			},
-			"network_id": schema.StringAttribute{
-				Description: "The ID of the network",
-				Computed:    true,
-			},
-			"prefixes": schema.ListAttribute{
-				ElementType: types.StringType,
-				Computed:    true,
-			},
-			"prefix_length_v4": schema.Int64Attribute{
-				Description: "prefix length",
-				Computed:    true,
-			},
-			"public_ip": schema.StringAttribute{
-				Description: "public IP address",
-				Computed:    true,
-			},
+		common.DataSourceArguments{
+			Required: []string{"id", "project_id"},
 		},
+	)
+	resp.Diagnostics.Append(diags...)
+	// kept for compatibility, it's equal to `id`
+	s.Attributes["network_id"] = schema.StringAttribute{
+		Description: "The ID of the network",
+		Computed:    true,
 	}
+	resp.Schema = s
 }
@@ -5,10 +5,9 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/bucket"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -24,53 +23,19 @@ type Bucket struct {
 }
 
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Data source for Object Storage buckets\n%s",
+	rs := resource.SchemaResponse{}
+	bucket.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for Object Storage buckets\n%s",
 			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Specifies the resource ID",
-				Computed:    true,
-			},
-
-			"name": schema.StringAttribute{
-				Description: "the bucket name",
-				Required:    true,
-			},
-
-			"object_storage_project_id": schema.StringAttribute{
-				Description:        "The ID returned from `stackit_object_storage_project`",
-				DeprecationMessage: "Use `project_id` instead.",
-				Optional:           true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
-
-			"project_id": schema.StringAttribute{
-				Description: "The ID returned from `stackit_object_storage_project`",
-				Required:    true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
-
-			"region": schema.StringAttribute{
-				Description: "the region where the bucket was created",
-				Computed:    true,
-			},
-
-			"host_style_url": schema.StringAttribute{
-				Description: "url with dedicated host name",
-				Computed:    true,
-			},
-
-			"path_style_url": schema.StringAttribute{
-				Description: "url with path to the bucket",
-				Computed:    true,
-			},
+		common.DataSourceArguments{
+			Required: []string{"name", "project_id"},
+			Optional: []string{"object_storage_project_id"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	resp.Schema = s
 }
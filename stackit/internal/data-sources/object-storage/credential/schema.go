@@ -5,10 +5,9 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/credential"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -23,49 +22,21 @@ type Credential struct {
 }
 
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Data source for Object Storage credentials\n%s",
+	rs := resource.SchemaResponse{}
+	credential.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for Object Storage credentials\n%s",
 			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "the credential ID",
-				Optional:    true,
-				Computed:    true,
-			},
-
-			"object_storage_project_id": schema.StringAttribute{
-				Description:        "The ID returned from `stackit_object_storage_project`",
-				DeprecationMessage: "Use `project_id` instead.",
-				Optional:           true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
-
-			"project_id": schema.StringAttribute{
-				Description: "The ID returned from `stackit_object_storage_project`",
-				Required:    true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
-
-			"credentials_group_id": schema.StringAttribute{
-				Description: "the credentials group ID",
-				Required:    true,
-			},
-
-			"expiry": schema.StringAttribute{
-				Computed: true,
-			},
-
-			"display_name": schema.StringAttribute{
-				Description: "the credential's display name in the portal",
-				Computed:    true,
-				Optional:    true,
-			},
+		common.DataSourceArguments{
+			Required:         []string{"project_id", "credentials_group_id"},
+			Optional:         []string{"object_storage_project_id"},
+			OptionalComputed: []string{"id", "display_name"},
+			Exclude:          []string{"access_key", "secret_access_key"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	resp.Schema = s
 }
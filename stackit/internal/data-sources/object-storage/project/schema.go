@@ -5,31 +5,25 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/project"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		DeprecationMessage: "This data source is deprecated and will be removed in a future version of the provider.",
-		MarkdownDescription: fmt.Sprintf("Data source for Object Storage project\n%s",
+	rs := resource.SchemaResponse{}
+	project.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for Object Storage project\n%s",
 			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Specifies the resource ID",
-				Computed:    true,
-			},
-			"project_id": schema.StringAttribute{
-				Description: "The project ID in which Object Storage is enabled",
-				Required:    true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
+		common.DataSourceArguments{
+			Required: []string{"project_id"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	s.DeprecationMessage = "This data source is deprecated and will be removed in a future version of the provider."
+	resp.Schema = s
 }
@@ -0,0 +1,48 @@
+package flavors_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_PostgresFlexFlavors(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.stackit_postgres_flex_flavors.example", "project_id", common.GetAcceptanceTestsProjectID()),
+					resource.TestCheckResourceAttrSet("data.stackit_postgres_flex_flavors.example", "flavors.0.id"),
+					resource.TestCheckResourceAttrSet("data.stackit_postgres_flex_flavors.example", "flavors.0.cpu"),
+					resource.TestCheckResourceAttrSet("data.stackit_postgres_flex_flavors.example", "flavors.0.memory"),
+				),
+			},
+		},
+	})
+}
+
+func config() string {
+	return fmt.Sprintf(`
+	data "stackit_postgres_flex_flavors" "example" {
+		project_id   = "%s"
+	}
+	`,
+		common.GetAcceptanceTestsProjectID(),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	postgresinstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/postgres-flex/instance"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -77,7 +78,7 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 			}
 		}
 	}
-	config.ACL = types.ListValueMust(types.StringType, elems)
+	config.ACL = types.SetValueMust(types.StringType, elems)
 	config.BackupSchedule = types.StringNull()
 	if i.BackupSchedule != nil {
 		config.BackupSchedule = types.StringValue(*i.BackupSchedule)
@@ -121,6 +122,22 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 	if i.Version != nil {
 		config.Version = types.StringValue(*i.Version)
 	}
+
+	opts := map[string]string{}
+	if i.Options != nil {
+		opts = *i.Options
+	}
+	remote, ext := postgresinstance.SplitOptions(opts)
+	params := map[string]attr.Value{}
+	for k, v := range remote {
+		params[k] = types.StringValue(v)
+	}
+	extensions := []attr.Value{}
+	for _, e := range ext {
+		extensions = append(extensions, types.StringValue(e))
+	}
+	config.Parameters = types.MapValueMust(types.StringType, params)
+	config.Extensions = types.SetValueMust(types.StringType, extensions)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
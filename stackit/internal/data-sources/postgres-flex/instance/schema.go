@@ -5,10 +5,9 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	postgresinstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/postgres-flex/instance"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,67 +20,26 @@ type Instance struct {
 	Version        types.String `tfsdk:"version"`
 	Replicas       types.Int64  `tfsdk:"replicas"`
 	BackupSchedule types.String `tfsdk:"backup_schedule"`
-	ACL            types.List   `tfsdk:"acl"`
+	ACL            types.Set    `tfsdk:"acl"`
 	Storage        types.Object `tfsdk:"storage"`
+	Parameters     types.Map    `tfsdk:"parameters"`
+	Extensions     types.Set    `tfsdk:"extensions"`
 }
 
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Data source for Postgres Flex instance\n%s",
+	rs := resource.SchemaResponse{}
+	postgresinstance.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for Postgres Flex instance\n%s",
 			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Specifies the resource ID",
-				Computed:    true,
-			},
-			"name": schema.StringAttribute{
-				Description: "Specifies the instance name",
-				Required:    true,
-			},
-			"project_id": schema.StringAttribute{
-				Description: "The project ID",
-				Required:    true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
-			"machine_type": schema.StringAttribute{
-				Description: "The Machine Type",
-				Computed:    true,
-			},
-			"version": schema.StringAttribute{
-				Description: "Postgres version",
-				Computed:    true,
-			},
-			"replicas": schema.Int64Attribute{
-				Description: "Number of replicas",
-				Computed:    true,
-			},
-			"backup_schedule": schema.StringAttribute{
-				Description: "Specifies the backup schedule (cron style)",
-				Computed:    true,
-			},
-			"storage": schema.SingleNestedAttribute{
-				Description: "A single `storage` block as defined below",
-				Computed:    true,
-				Attributes: map[string]schema.Attribute{
-					"class": schema.StringAttribute{
-						Description: "Specifies the storage class. Available option: `premium-perf2-stackit` `premium-perf4-stackit` `premium-perf6-stackit` `premium-perf8-stackit` `premium-perf10-stackit` `premium-perf12-stackit`",
-						Computed:    true,
-					},
-					"size": schema.Int64Attribute{
-						Description: "The storage size in GB",
-						Computed:    true,
-					},
-				},
-			},
-			"acl": schema.ListAttribute{
-				Description: "Access Control rules to whitelist IP addresses",
-				ElementType: types.StringType,
-				Computed:    true,
-			},
+		common.DataSourceArguments{
+			Required: []string{"name", "project_id"},
+			Exclude:  []string{"allow_replace_on_version_change", "labels", "options"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	resp.Schema = s
 }
@@ -38,6 +38,7 @@ func (r DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *
 		}
 	}
 	config.Roles = types.ListValueMust(types.StringType, roles)
+	config.RoleSet = types.SetValueMust(types.StringType, roles)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -5,10 +5,9 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	postgresuser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/postgres-flex/user"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,47 +20,23 @@ type User struct {
 	Host       types.String `tfsdk:"host"`
 	Port       types.Int64  `tfsdk:"port"`
 	Roles      types.List   `tfsdk:"roles"`
+	RoleSet    types.Set    `tfsdk:"role_set"`
 }
 
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Data source for Postgres Flex user\n%s",
+	rs := resource.SchemaResponse{}
+	postgresuser.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for Postgres Flex user\n%s",
 			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Specifies the resource ID",
-				Required:    true,
-			},
-			"instance_id": schema.StringAttribute{
-				Description: "the postgres db flex instance id.",
-				Required:    true,
-			},
-			"project_id": schema.StringAttribute{
-				Description: "The project ID the instance runs in. ",
-				Required:    true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
-			"username": schema.StringAttribute{
-				Description: "Specifies the username",
-				Computed:    true,
-			},
-			"host": schema.StringAttribute{
-				Description: "Specifies the allowed user hostname",
-				Computed:    true,
-			},
-			"port": schema.Int64Attribute{
-				Description: "Specifies the port",
-				Computed:    true,
-			},
-			"roles": schema.ListAttribute{
-				Description: "Specifies the roles assigned to the user",
-				Computed:    true,
-				ElementType: types.StringType,
-			},
+		common.DataSourceArguments{
+			Required: []string{"id", "instance_id", "project_id"},
+			Exclude:  []string{"password", "uri"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	resp.Schema = s
 }
@@ -5,8 +5,9 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/project"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,44 +22,20 @@ type Project struct {
 }
 
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Data source for STACKIT projects\n%s",
+	rs := resource.SchemaResponse{}
+	project.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for STACKIT projects\n%s",
 			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "the project UUID",
-				Computed:    true,
-			},
-
-			"container_id": schema.StringAttribute{
-				Description: "the project container ID",
-				Required:    true,
-			},
-
-			"parent_container_id": schema.StringAttribute{
-				Description: "the project's parent container ID",
-				Computed:    true,
-			},
-
-			"name": schema.StringAttribute{
-				Description: "the project name",
-				Computed:    true,
-			},
-
-			"billing_ref": schema.StringAttribute{
-				Description: "billing reference for cost transparency",
-				Computed:    true,
-			},
-
-			"labels": schema.MapAttribute{
-				Description: "Extend project information with custom label values.",
-				Required:    false,
-				Computed:    false,
-				Optional:    true,
-				ElementType: types.StringType,
-			},
+		common.DataSourceArguments{
+			Required:         []string{"container_id"},
+			OptionalComputed: []string{"labels"},
+			Exclude:          []string{"owner_email"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	resp.Schema = s
 }
@@ -5,10 +5,9 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/instance"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -23,43 +22,18 @@ type Instance struct {
 }
 
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Manages Secrets Manager instances\n%s",
+	rs := resource.SchemaResponse{}
+	instance.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for Secrets Manager instances\n%s",
 			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Specifies the resource UUID.",
-				Required:    true,
-				Validators: []validator.String{
-					validate.UUID(),
-				},
-			},
-			"project_id": schema.StringAttribute{
-				Description: "The project UUID.",
-				Required:    true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
-			"name": schema.StringAttribute{
-				Description: "Specifies the instance name.",
-				Computed:    true,
-			},
-			"frontend_url": schema.StringAttribute{
-				Description: "Specifies the frontend for managing secrets.",
-				Computed:    true,
-			},
-			"api_url": schema.StringAttribute{
-				Description: "Specifies the API URL for managing secrets.",
-				Computed:    true,
-			},
-			"acl": schema.SetAttribute{
-				Description: "Specifies the access list for the instance.",
-				Computed:    true,
-				ElementType: types.StringType,
-			},
+		common.DataSourceArguments{
+			Required: []string{"id", "project_id"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	resp.Schema = s
 }
@@ -0,0 +1,52 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/secret"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Read - lifecycle function
+func (d DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Secret
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v, err := secret.NewVault(ctx, config.APIURL.ValueString(), config.InstanceID.ValueString(), config.Username.ValueString(), config.Password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to authenticate", err.Error())
+		return
+	}
+
+	s, err := v.Read(ctx, config.Path.ValueString(), config.Version.ValueInt64())
+	if err != nil {
+		if errors.Is(err, secret.ErrNotFound) {
+			resp.Diagnostics.AddError("failed to find secret", fmt.Sprintf("secret '%s' (version %d) not found", config.Path.ValueString(), config.Version.ValueInt64()))
+			return
+		}
+		resp.Diagnostics.AddError("failed to read secret", err.Error())
+		return
+	}
+
+	data, diags := types.MapValueFrom(ctx, types.StringType, s.Data)
+	resp.Diagnostics.Append(diags...)
+	md, diags := types.MapValueFrom(ctx, types.StringType, s.CustomMetadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = config.Path
+	config.Version = types.Int64Value(s.Version)
+	config.Data = data
+	config.CustomMetadata = md
+	config.CreatedTime = types.StringValue(s.CreatedTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,24 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// New returns a new configured data source
+func New() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource is the exported data source
+// secrets are read with the credentials of a Secrets Manager user,
+// therefore the provider's client isn't used
+type DataSource struct{}
+
+var _ = datasource.DataSource(&DataSource{})
+
+// Metadata returns data resource metadata
+func (d *DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = "stackit_secrets_manager_secret"
+}
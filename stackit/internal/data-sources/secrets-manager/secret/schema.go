@@ -0,0 +1,58 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/secret"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Secret is the schema model
+type Secret struct {
+	ID             types.String `tfsdk:"id"`
+	APIURL         types.String `tfsdk:"api_url"`
+	InstanceID     types.String `tfsdk:"instance_id"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	Path           types.String `tfsdk:"path"`
+	Version        types.Int64  `tfsdk:"version"`
+	Data           types.Map    `tfsdk:"data"`
+	CustomMetadata types.Map    `tfsdk:"custom_metadata"`
+	CreatedTime    types.String `tfsdk:"created_time"`
+}
+
+// Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	rs := resource.SchemaResponse{}
+	secret.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		"Data source for secrets in the KV v2 engine of a Secrets Manager instance\n\n"+
+			"The secret is read with the credentials of a `stackit_secrets_manager_user` against the instance's `api_url`.",
+		common.DataSourceArguments{
+			Required: []string{"api_url", "instance_id", "username", "password", "path"},
+			Exclude:  []string{"cas"},
+		},
+	)
+	resp.Diagnostics.Append(diags...)
+	// unlike in the resource, the version selects which version is read
+	s.Attributes["version"] = schema.Int64Attribute{
+		Description: "Specifies the version to read. The latest version is read by default.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+	s.Attributes["created_time"] = schema.StringAttribute{
+		Description: "The creation time of the secret version.",
+		Computed:    true,
+	}
+	resp.Schema = s
+}
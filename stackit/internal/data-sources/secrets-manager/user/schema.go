@@ -5,10 +5,9 @@ import (
 	"fmt"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/user"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -23,42 +22,20 @@ type User struct {
 }
 
 // Schema returns the terraform schema structure
+// it is derived from the resource schema, so both stay in sync
 func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Manages Secrets Manager instances\n%s",
+	rs := resource.SchemaResponse{}
+	user.New().Schema(ctx, resource.SchemaRequest{}, &rs)
+	s, diags := common.DataSourceSchema(rs.Schema,
+		fmt.Sprintf("Data source for Secrets Manager users\n%s",
 			common.EnvironmentInfo(d.urls),
 		),
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Specifies the user UUID.",
-				Computed:    true,
-			},
-			"project_id": schema.StringAttribute{
-				Description: "The project UUID.",
-				Required:    true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
-			"instance_id": schema.StringAttribute{
-				Description: "Specifies the instance UUID.",
-				Required:    true,
-				Validators: []validator.String{
-					validate.UUID(),
-				},
-			},
-			"username": schema.StringAttribute{
-				Description: "Specifies the user name.",
-				Required:    true,
-			},
-			"description": schema.StringAttribute{
-				Description: "Specifies the description of the user. Changing this value requires the resource to be recreated.",
-				Computed:    true,
-			},
-			"write_enabled": schema.BoolAttribute{
-				Description: "Specifies if the user can write secrets. `false` by default.",
-				Optional:    true,
-			},
+		common.DataSourceArguments{
+			Required: []string{"project_id", "instance_id", "username"},
+			Optional: []string{"write_enabled"},
+			Exclude:  []string{"password"},
 		},
-	}
+	)
+	resp.Diagnostics.Append(diags...)
+	resp.Schema = s
 }
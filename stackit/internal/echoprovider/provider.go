@@ -0,0 +1,112 @@
+// Package echoprovider implements the `echo` provider for acceptance tests of ephemeral resources
+// ephemeral values can't be stored in the state, but they can be passed to a provider configuration:
+// the `data` of the `echo` provider configuration is stored in the `data` attribute of every `echo` resource,
+// where the test checks can assert it
+package echoprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	pschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// NewProviderServer returns the `echo` provider server for ProtoV6ProviderFactories
+func NewProviderServer() func() (tfprotov6.ProviderServer, error) {
+	return providerserver.NewProtocol6WithError(&echoProvider{})
+}
+
+type echoProvider struct{}
+
+var _ = provider.Provider(&echoProvider{})
+
+// Metadata returns the provider type name
+func (p *echoProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "echo"
+}
+
+// Schema returns the provider schema
+func (p *echoProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = pschema.Schema{
+		Attributes: map[string]pschema.Attribute{
+			"data": pschema.DynamicAttribute{
+				Description: "The value stored in the `data` attribute of `echo` resources, e.g. an ephemeral value.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure passes the configured data to the resources
+func (p *echoProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data types.Dynamic
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data"), &data)...)
+	resp.ResourceData = data
+}
+
+// DataSources returns no data sources
+func (p *echoProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// Resources returns the `echo` resource
+func (p *echoProvider) Resources(context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		func() resource.Resource { return &echoResource{} },
+	}
+}
+
+type echoResource struct {
+	data types.Dynamic
+}
+
+var _ = resource.ResourceWithConfigure(&echoResource{})
+
+// Metadata returns the resource type name
+func (r *echoResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName
+}
+
+// Schema returns the resource schema
+func (r *echoResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"data": rschema.DynamicAttribute{
+				Description: "The `data` of the provider configuration.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure stores the provider data
+func (r *echoResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if data, ok := req.ProviderData.(types.Dynamic); ok {
+		r.data = data
+	}
+}
+
+// Create - lifecycle function
+func (r *echoResource) Create(ctx context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data"), r.data)...)
+}
+
+// Read - lifecycle function
+func (r *echoResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+}
+
+// Update - lifecycle function
+func (r *echoResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data"), r.data)...)
+}
+
+// Delete - lifecycle function
+func (r *echoResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
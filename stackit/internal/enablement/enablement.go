@@ -0,0 +1,129 @@
+// Package enablement enables services for projects once per provider
+// resources created in parallel share a single enablement call per project and service,
+// and services that are known to be enabled aren't checked again while they're cached
+package enablement
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/project"
+	serviceenablement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-enablement/v1"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// ServiceSKE is the service enablement ID of SKE
+	ServiceSKE = "cloud.stackit.ske"
+
+	// keys of services that are enabled through their own API
+	serviceLoadBalancer  = "load-balancer"
+	serviceObjectStorage = "object-storage"
+)
+
+// Manager enables services for projects
+// enablement is deduplicated and remembered with the cache of the provider client
+type Manager struct {
+	client *providerdata.Client
+	cache  *cache.Cache
+}
+
+// For returns the manager of the configured provider client
+func For(c *providerdata.Client) *Manager {
+	return &Manager{client: c, cache: c.Cache}
+}
+
+func key(projectID, service string) string {
+	return cache.Key("enablement", projectID, service)
+}
+
+// Ensure runs enable once for the project and service
+// it's a cache.Load: concurrent callers share the running call, and only successful calls are cached,
+// so failures are retried by the next caller
+func (m *Manager) Ensure(ctx context.Context, projectID, service string, enable func(ctx context.Context) error) error {
+	_, err := cache.Load(ctx, m.cache, key(projectID, service), func(ctx context.Context) (bool, error) {
+		tflog.Debug(ctx, fmt.Sprintf("enabling service %s for project %s", service, projectID))
+		return true, enable(ctx)
+	})
+	return err
+}
+
+// Forget removes the project and service from the cache, e.g. after the service was disabled
+func (m *Manager) Forget(projectID, service string) {
+	m.cache.Forget(key(projectID, service))
+}
+
+// EnableService enables a service using the service enablement API
+func (m *Manager) EnableService(ctx context.Context, projectID, serviceID string, timeout time.Duration) error {
+	return m.Ensure(ctx, projectID, serviceID, func(ctx context.Context) error {
+		c := m.client.ServiceEnablement
+
+		status, err := c.GetService(ctx, projectID, serviceID)
+		if agg := validate.Response(status, err, "JSON200.State"); agg != nil {
+			if !validate.StatusEquals(status, http.StatusNotFound) {
+				return fmt.Errorf("failed to fetch status of service %s: %w", serviceID, agg)
+			}
+		} else if *status.JSON200.State == serviceenablement.ENABLED {
+			return nil
+		}
+
+		res, err := c.EnableService(ctx, projectID, serviceID)
+		if agg := validate.Response(res, err); agg != nil {
+			return fmt.Errorf("failed to enable service %s: %w", serviceID, agg)
+		}
+
+		process := res.WaitHandler(ctx, c, projectID, serviceID).SetTimeout(timeout)
+		if _, err := process.WaitWithContext(ctx); err != nil {
+			return fmt.Errorf("failed to verify enablement of service %s: %w", serviceID, err)
+		}
+		return nil
+	})
+}
+
+// EnableLoadBalancer enables the load balancer service for the project if it isn't ready yet
+func (m *Manager) EnableLoadBalancer(ctx context.Context, projectID string) error {
+	return m.Ensure(ctx, projectID, serviceLoadBalancer, func(ctx context.Context) error {
+		c := m.client.LoadBalancer.Project
+
+		status, err := c.GetStatus(ctx, projectID)
+		if agg := validate.Response(status, err, "JSON200.Status"); agg != nil {
+			return fmt.Errorf("couldn't get project status: %w", agg)
+		}
+		if *status.JSON200.Status == project.STATUS_READY {
+			return nil
+		}
+
+		res, err := c.EnableProject(ctx, projectID, &project.EnableProjectParams{})
+		if agg := validate.Response(res, err); agg != nil {
+			return fmt.Errorf("couldn't enable project: %w", agg)
+		}
+		process := res.WaitHandler(ctx, c, projectID)
+		if _, err := process.WaitWithContext(ctx); err != nil {
+			return fmt.Errorf("received an error while waiting for project to be enabled: %w", err)
+		}
+		return nil
+	})
+}
+
+// EnableObjectStorage initializes object storage for the project
+func (m *Manager) EnableObjectStorage(ctx context.Context, projectID string) error {
+	return m.Ensure(ctx, projectID, serviceObjectStorage, func(ctx context.Context) error {
+		c := m.client.ObjectStorage.Project
+
+		status, err := c.Get(ctx, projectID)
+		if agg := validate.Response(status, err, "JSON200"); agg != nil && !validate.StatusEquals(status, http.StatusNotFound) {
+			return fmt.Errorf("failed to fetch Object Storage project status: %w", agg)
+		}
+
+		res, err := c.Create(ctx, projectID)
+		if agg := validate.Response(res, err); agg != nil {
+			return fmt.Errorf("failed during Object Storage project init: %w", agg)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,99 @@
+package enablement
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+func TestEnsure(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+
+	t.Run("parallel callers share a single call", func(t *testing.T) {
+		m := &Manager{cache: cache.New(cache.DefaultTTL)}
+		var calls int32
+		enable := func(context.Context) error {
+			atomic.AddInt32(&calls, 1)
+			time.Sleep(20 * time.Millisecond)
+			return nil
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := m.Ensure(ctx, "project", "service", enable); err != nil {
+					t.Errorf("Ensure() error = %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		// cached
+		if err := m.Ensure(ctx, "project", "service", enable); err != nil {
+			t.Errorf("Ensure() error = %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("enable called %d times, want 1", calls)
+		}
+
+		// other projects and services are enabled separately
+		_ = m.Ensure(ctx, "project", "other", enable)
+		_ = m.Ensure(ctx, "other", "service", enable)
+		if calls != 3 {
+			t.Errorf("enable called %d times, want 3", calls)
+		}
+	})
+
+	t.Run("failures aren't cached", func(t *testing.T) {
+		m := &Manager{cache: cache.New(cache.DefaultTTL)}
+		errFailed := errors.New("failed")
+		calls := 0
+		enable := func(context.Context) error {
+			calls++
+			if calls == 1 {
+				return errFailed
+			}
+			return nil
+		}
+
+		if err := m.Ensure(ctx, "project", "service", enable); !errors.Is(err, errFailed) {
+			t.Errorf("Ensure() error = %v, want %v", err, errFailed)
+		}
+		if err := m.Ensure(ctx, "project", "service", enable); err != nil {
+			t.Errorf("Ensure() error = %v", err)
+		}
+		if calls != 2 {
+			t.Errorf("enable called %d times, want 2", calls)
+		}
+	})
+
+	t.Run("forget", func(t *testing.T) {
+		m := &Manager{cache: cache.New(cache.DefaultTTL)}
+		calls := 0
+		enable := func(context.Context) error {
+			calls++
+			return nil
+		}
+
+		_ = m.Ensure(ctx, "project", "service", enable)
+		m.Forget("project", "service")
+		_ = m.Ensure(ctx, "project", "service", enable)
+		if calls != 2 {
+			t.Errorf("enable called %d times, want 2", calls)
+		}
+	})
+}
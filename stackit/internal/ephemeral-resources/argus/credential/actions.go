@@ -0,0 +1,75 @@
+package credential
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// privateKey is the private state key holding the credential to delete on close
+const privateKey = "credential"
+
+type privateData struct {
+	ProjectID  string `json:"project_id"`
+	InstanceID string `json:"instance_id"`
+	Username   string `json:"username"`
+}
+
+// Open - lifecycle function
+func (e *EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var cred Credential
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cred)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := e.client.Instances.CredentialsCreate(ctx, cred.ProjectID.ValueString(), cred.InstanceID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed to create argus instance credentials", agg.Error())
+		return
+	}
+	cred.Username = types.StringValue(res.JSON201.Credentials.Username)
+	cred.Password = types.StringValue(res.JSON201.Credentials.Password)
+
+	b, err := json.Marshal(privateData{
+		ProjectID:  cred.ProjectID.ValueString(),
+		InstanceID: cred.InstanceID.ValueString(),
+		Username:   res.JSON201.Credentials.Username,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to store credential reference", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKey, b)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &cred)...)
+}
+
+// Close - lifecycle function
+func (e *EphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	b, diags := req.Private.GetKey(ctx, privateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || b == nil {
+		return
+	}
+
+	var data privateData
+	if err := json.Unmarshal(b, &data); err != nil {
+		resp.Diagnostics.AddError("failed to read credential reference", err.Error())
+		return
+	}
+
+	res, err := e.client.Instances.CredentialsDelete(ctx, data.ProjectID, data.InstanceID, data.Username)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		// ignore non-existing...
+		if !strings.Contains(agg.Error(), "EOF") && !validate.StatusEquals(res, http.StatusNotFound) {
+			resp.Diagnostics.AddError("failed to delete credential", agg.Error())
+			return
+		}
+	}
+}
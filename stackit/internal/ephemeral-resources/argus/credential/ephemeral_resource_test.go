@@ -0,0 +1,66 @@
+package credential_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/echoprovider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_EphemeralArgusCredential(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "argus" + acctest.RandStringFromCharSet(7, acctest.CharSetAlpha)
+	projectID := common.GetAcceptanceTestsProjectID()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(projectID, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.example", "data"),
+					resource.TestCheckResourceAttrSet("stackit_argus_instance.example", "id"),
+				),
+			},
+		},
+	})
+}
+
+func config(projectID, name string) string {
+	return fmt.Sprintf(`
+	resource "stackit_argus_instance" "example" {
+		project_id = "%s"
+		name       = "%s"
+		plan       = "Monitoring-Medium-EU01"
+	}
+
+	ephemeral "stackit_argus_credential" "example" {
+		project_id  = stackit_argus_instance.example.project_id
+		instance_id = stackit_argus_instance.example.id
+	}
+
+	provider "echo" {
+		data = ephemeral.stackit_argus_credential.example.password
+	}
+
+	resource "echo" "example" {}
+	`,
+		projectID,
+		name,
+	)
+}
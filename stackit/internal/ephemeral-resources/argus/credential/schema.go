@@ -0,0 +1,52 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Credential is the schema model
+type Credential struct {
+	ProjectID  types.String `tfsdk:"project_id"`
+	InstanceID types.String `tfsdk:"instance_id"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+}
+
+// Schema returns the terraform schema structure
+func (e *EphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Creates temporary Argus instance credentials, which are deleted once Terraform is done using them and aren't stored in the state. Requires Terraform 1.10 or later.\n%s",
+			common.EnvironmentInfo(e.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "Project ID the credential belongs to",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "Instance ID the credential belongs to",
+				Required:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Credential username",
+				Computed:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Credential password",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
@@ -0,0 +1,27 @@
+package kubeconfig
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Open - lifecycle function
+func (e *EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config Kubeconfig
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := e.client.Kubernetes.Credentials.List(ctx, config.ProjectID.ValueString(), config.ClusterName.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200.Kubeconfig"); agg != nil {
+		resp.Diagnostics.AddError("failed to get cluster credentials", agg.Error())
+		return
+	}
+	config.KubeConfig = types.StringValue(*res.JSON200.Kubeconfig)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
@@ -0,0 +1,52 @@
+package kubeconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
+	kubernetes "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+)
+
+// New returns a new configured ephemeral resource
+func New() ephemeral.EphemeralResource {
+	return &EphemeralResource{
+		urls: kubernetes.BaseURLs,
+	}
+}
+
+// EphemeralResource is the exported ephemeral resource
+type EphemeralResource struct {
+	client *providerdata.Client
+	urls   baseurl.BaseURL
+}
+
+var _ = ephemeral.EphemeralResourceWithConfigure(&EphemeralResource{})
+
+// Metadata returns ephemeral resource metadata
+func (e *EphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, res *ephemeral.MetadataResponse) {
+	res.TypeName = "stackit_kubernetes_kubeconfig"
+}
+
+// Configure configures the ephemeral resource client
+func (e *EphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*providerdata.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = client
+}
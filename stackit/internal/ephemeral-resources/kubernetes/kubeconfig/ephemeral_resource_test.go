@@ -0,0 +1,71 @@
+package kubeconfig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/echoprovider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_EphemeralKubernetesKubeconfig(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.example", "data"),
+					resource.TestCheckResourceAttr("stackit_kubernetes_cluster.example", "name", name),
+				),
+			},
+		},
+	})
+}
+
+func config(name string) string {
+	return fmt.Sprintf(`
+resource "stackit_kubernetes_cluster" "example" {
+	project_id         = "%s"
+	name               = "%s"
+	kubernetes_version = "1.26"
+
+	node_pools = [{
+		name         = "example"
+		machine_type = "c1.2"
+		zones        = ["eu01-1"]
+		maximum      = 1
+	}]
+}
+
+ephemeral "stackit_kubernetes_kubeconfig" "example" {
+	project_id   = stackit_kubernetes_cluster.example.project_id
+	cluster_name = stackit_kubernetes_cluster.example.name
+}
+
+provider "echo" {
+	data = ephemeral.stackit_kubernetes_kubeconfig.example.kube_config
+}
+
+resource "echo" "example" {}
+`,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+	)
+}
@@ -0,0 +1,51 @@
+package kubeconfig
+
+import (
+	"context"
+	"fmt"
+
+	clientCluster "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Kubeconfig is the schema model
+type Kubeconfig struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	ClusterName types.String `tfsdk:"cluster_name"`
+	KubeConfig  types.String `tfsdk:"kube_config"`
+}
+
+// Schema returns the terraform schema structure
+func (e *EphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Reads the kube config of a SKE cluster without storing it in the state. Requires Terraform 1.10 or later.\n%s",
+			common.EnvironmentInfo(e.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				Description: "The cluster name.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.StringWith(clientCluster.ValidateClusterName, "validate cluster name"),
+				},
+			},
+			"kube_config": schema.StringAttribute{
+				Description: "Kube config file used for connecting to the cluster",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
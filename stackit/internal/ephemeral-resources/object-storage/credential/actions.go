@@ -0,0 +1,90 @@
+package credential
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	accesskey "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1/access-key"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// privateKey is the private state key holding the credential to delete on close
+const privateKey = "credential"
+
+type privateData struct {
+	ProjectID          string `json:"project_id"`
+	CredentialsGroupID string `json:"credentials_group_id"`
+	KeyID              string `json:"key_id"`
+}
+
+// Open - lifecycle function
+func (e *EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var cred Credential
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cred)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := accesskey.CreateJSONRequestBody{}
+	if !cred.Expiry.IsNull() {
+		t, err := time.Parse("2006-01-02T15:04:05.999Z", cred.Expiry.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("couldn't parse expiry", err.Error())
+			return
+		}
+		body.Expires = &t
+	}
+	params := &accesskey.CreateParams{
+		CredentialsGroup: cred.CredentialsGroupID.ValueStringPointer(),
+	}
+
+	res, err := e.client.ObjectStorage.AccessKey.Create(ctx, cred.ProjectID.ValueString(), params, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed to create credential", agg.Error())
+		return
+	}
+	k := res.JSON201
+	cred.AccessKey = types.StringValue(k.AccessKey)
+	cred.SecretAccessKey = types.StringValue(k.SecretAccessKey)
+
+	b, err := json.Marshal(privateData{
+		ProjectID:          cred.ProjectID.ValueString(),
+		CredentialsGroupID: cred.CredentialsGroupID.ValueString(),
+		KeyID:              k.KeyID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to store credential reference", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKey, b)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &cred)...)
+}
+
+// Close - lifecycle function
+func (e *EphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	b, diags := req.Private.GetKey(ctx, privateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || b == nil {
+		return
+	}
+
+	var data privateData
+	if err := json.Unmarshal(b, &data); err != nil {
+		resp.Diagnostics.AddError("failed to read credential reference", err.Error())
+		return
+	}
+
+	params := &accesskey.DeleteParams{}
+	if data.CredentialsGroupID != "" {
+		params.CredentialsGroup = &data.CredentialsGroupID
+	}
+	res, err := e.client.ObjectStorage.AccessKey.Delete(ctx, data.ProjectID, data.KeyID, params)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil && !validate.StatusEquals(res, http.StatusNotFound) {
+		resp.Diagnostics.AddError("failed to delete credential", agg.Error())
+	}
+}
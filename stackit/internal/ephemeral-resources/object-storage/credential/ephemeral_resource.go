@@ -0,0 +1,53 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
+	objectstorage "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+)
+
+// New returns a new configured ephemeral resource
+func New() ephemeral.EphemeralResource {
+	return &EphemeralResource{
+		urls: objectstorage.BaseURLs,
+	}
+}
+
+// EphemeralResource is the exported ephemeral resource
+type EphemeralResource struct {
+	client *providerdata.Client
+	urls   baseurl.BaseURL
+}
+
+var _ = ephemeral.EphemeralResourceWithConfigure(&EphemeralResource{})
+var _ = ephemeral.EphemeralResourceWithClose(&EphemeralResource{})
+
+// Metadata returns ephemeral resource metadata
+func (e *EphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, res *ephemeral.MetadataResponse) {
+	res.TypeName = "stackit_object_storage_credential"
+}
+
+// Configure configures the ephemeral resource client
+func (e *EphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*providerdata.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *providerdata.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	e.client = c
+}
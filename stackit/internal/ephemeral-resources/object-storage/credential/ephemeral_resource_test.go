@@ -0,0 +1,58 @@
+package credential_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/echoprovider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_EphemeralObjectStorageCredential(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(common.GetAcceptanceTestsProjectID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.example", "data"),
+					resource.TestCheckResourceAttrSet("stackit_object_storage_project.example", "id"),
+				),
+			},
+		},
+	})
+}
+
+func config(projectID string) string {
+	return fmt.Sprintf(`
+	resource "stackit_object_storage_project" "example" {
+		project_id = "%s"
+	}
+
+	ephemeral "stackit_object_storage_credential" "example" {
+		project_id = stackit_object_storage_project.example.id
+	}
+
+	provider "echo" {
+		data = ephemeral.stackit_object_storage_credential.example.secret_access_key
+	}
+
+	resource "echo" "example" {}
+	`,
+		projectID,
+	)
+}
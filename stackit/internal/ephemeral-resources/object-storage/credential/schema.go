@@ -0,0 +1,63 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Credential is the schema model
+type Credential struct {
+	ProjectID          types.String `tfsdk:"project_id"`
+	CredentialsGroupID types.String `tfsdk:"credentials_group_id"`
+	Expiry             types.String `tfsdk:"expiry"`
+	AccessKey          types.String `tfsdk:"access_key"`
+	SecretAccessKey    types.String `tfsdk:"secret_access_key"`
+}
+
+// Schema returns the terraform schema structure
+func (e *EphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Creates temporary Object Storage credentials, which are deleted once Terraform is done using them and aren't stored in the state. "+
+			"Object Storage needs to be enabled for the project, e.g. using `stackit_object_storage_project`. Requires Terraform 1.10 or later.\n%s",
+			common.EnvironmentInfo(e.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+			},
+			"credentials_group_id": schema.StringAttribute{
+				Description: "credential group ID. the default group is used if not set.",
+				Optional:    true,
+			},
+			"expiry": schema.StringAttribute{
+				Description: "specifies when the credential expires, in case it can't be deleted.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.StringWith(clientValidate.ISO8601, "validate expiry is ISO-8601 compatible"),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				Description: "access key (sensitive)",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Description: "secret access key (sensitive)",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package userpassword
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Open - lifecycle function
+func (e *EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config UserPassword
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := e.client.PostgresFlex.Users.ResetPassword(ctx, config.ProjectID.ValueString(), config.InstanceID.ValueString(), config.UserID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON202.Item.Password"); agg != nil {
+		resp.Diagnostics.AddError("failed resetting postgres flex db user password", agg.Error())
+		return
+	}
+
+	config.Password = types.StringValue(*res.JSON202.Item.Password)
+	config.URI = types.StringNull()
+	if res.JSON202.Item.URI != nil {
+		config.URI = types.StringValue(*res.JSON202.Item.URI)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
@@ -0,0 +1,74 @@
+package userpassword_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/echoprovider"
+	postgresinstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/postgres-flex/instance"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_EphemeralPostgresFlexUserPassword(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(7, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.example", "data"),
+					resource.TestCheckResourceAttrSet("stackit_postgres_flex_user.example", "id"),
+				),
+			},
+		},
+	})
+}
+
+func config(name string) string {
+	return fmt.Sprintf(`
+	resource "stackit_postgres_flex_instance" "example" {
+		name         = "%s"
+		project_id   = "%s"
+		machine_type = "%s"
+		version      = "14"
+	}
+
+	resource "stackit_postgres_flex_user" "example" {
+		project_id  = stackit_postgres_flex_instance.example.project_id
+		instance_id = stackit_postgres_flex_instance.example.id
+	}
+
+	ephemeral "stackit_postgres_flex_user_password" "example" {
+		project_id  = stackit_postgres_flex_user.example.project_id
+		instance_id = stackit_postgres_flex_user.example.instance_id
+		user_id     = stackit_postgres_flex_user.example.id
+	}
+
+	provider "echo" {
+		data = ephemeral.stackit_postgres_flex_user_password.example.password
+	}
+
+	resource "echo" "example" {}
+	`,
+		name,
+		common.GetAcceptanceTestsProjectID(),
+		postgresinstance.DefaultMachineType,
+	)
+}
@@ -0,0 +1,59 @@
+package userpassword
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UserPassword is the schema model
+type UserPassword struct {
+	ProjectID  types.String `tfsdk:"project_id"`
+	InstanceID types.String `tfsdk:"instance_id"`
+	UserID     types.String `tfsdk:"user_id"`
+	Password   types.String `tfsdk:"password"`
+	URI        types.String `tfsdk:"uri"`
+}
+
+// Schema returns the terraform schema structure
+func (e *EphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Resets the password of a Postgres Flex user every time it is opened, without storing it in the state. Requires Terraform 1.10 or later.\n\n"+
+			"~> **Note:** the password changes on every plan and apply, the `password` and `uri` of `stackit_postgres_flex_user` aren't valid afterwards.\n%s",
+			common.EnvironmentInfo(e.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "The Postgres Flex instance ID.",
+				Required:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The user ID, e.g. `stackit_postgres_flex_user.<name>.id`.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The new password.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"uri": schema.StringAttribute{
+				Description: "The connection URI, including the new password.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/users"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// privateKey is the private state key holding the user to delete on close
+const privateKey = "user"
+
+type privateData struct {
+	ProjectID  string `json:"project_id"`
+	InstanceID string `json:"instance_id"`
+	UserID     string `json:"user_id"`
+}
+
+// Open - lifecycle function
+func (e *EphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var user User
+	resp.Diagnostics.Append(req.Config.Get(ctx, &user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := e.client.SecretsManager.Users.Create(ctx, uuid.MustParse(user.ProjectID.ValueString()), uuid.MustParse(user.InstanceID.ValueString()), users.UserCreate{
+		Description: user.Description.ValueString(),
+		Write:       user.Write.ValueBool(),
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		resp.Diagnostics.AddError("failed to create user", agg.Error())
+		return
+	}
+	user.Description = types.StringValue(res.JSON200.Description)
+	user.Write = types.BoolValue(res.JSON200.Write)
+	user.Username = types.StringValue(res.JSON200.Username)
+	user.Password = types.StringValue(res.JSON200.Password)
+
+	b, err := json.Marshal(privateData{
+		ProjectID:  user.ProjectID.ValueString(),
+		InstanceID: user.InstanceID.ValueString(),
+		UserID:     res.JSON200.ID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to store user reference", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKey, b)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &user)...)
+}
+
+// Close - lifecycle function
+func (e *EphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	b, diags := req.Private.GetKey(ctx, privateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || b == nil {
+		return
+	}
+
+	var data privateData
+	if err := json.Unmarshal(b, &data); err != nil {
+		resp.Diagnostics.AddError("failed to read user reference", err.Error())
+		return
+	}
+
+	_, err := e.client.SecretsManager.Users.Delete(ctx, uuid.MustParse(data.ProjectID), uuid.MustParse(data.InstanceID), uuid.MustParse(data.UserID))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete user", err.Error())
+	}
+}
@@ -0,0 +1,65 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// User is the schema model
+type User struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	InstanceID  types.String `tfsdk:"instance_id"`
+	Description types.String `tfsdk:"description"`
+	Write       types.Bool   `tfsdk:"write_enabled"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+}
+
+// Schema returns the terraform schema structure
+func (e *EphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Creates a temporary Secrets Manager user, which is deleted once Terraform is done using it and isn't stored in the state. Requires Terraform 1.10 or later.\n%s",
+			common.EnvironmentInfo(e.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "Specifies the instance id.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Specifies the description of the user.",
+				Optional:    true,
+			},
+			"write_enabled": schema.BoolAttribute{
+				Description: "Specifies if the user can write secrets. `false` by default.",
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Specifies the user name.",
+				Computed:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Specifies the password.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NewKnownRanges returns the known_ranges function
+func NewKnownRanges() function.Function {
+	return &KnownRanges{}
+}
+
+// KnownRanges returns the IP ranges used by STACKIT
+type KnownRanges struct{}
+
+var _ = function.Function(&KnownRanges{})
+
+// Metadata returns the function name
+func (f *KnownRanges) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "known_ranges"
+}
+
+// Definition returns the function signature
+func (f *KnownRanges) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Known STACKIT IP ranges",
+		MarkdownDescription: "Returns the known IP ranges used by STACKIT, which are also the default `acl` of the flex instances",
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run returns the ranges
+func (f *KnownRanges) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	ranges := make([]string, len(common.KnownRanges))
+	copy(ranges, common.KnownRanges)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, ranges))
+}
@@ -0,0 +1,35 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestKnownRanges(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ListUnknown(types.StringType)),
+	}
+	NewKnownRanges().Run(context.Background(), function.RunRequest{}, &resp)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+	got := resp.Result.Value().(types.List).Elements()
+	if len(got) != len(common.KnownRanges) {
+		t.Fatalf("expected %d ranges, got %d", len(common.KnownRanges), len(got))
+	}
+	for i, v := range got {
+		if v.(types.String).ValueString() != common.KnownRanges[i] {
+			t.Errorf("range %d: expected %s, got %s", i, common.KnownRanges[i], v)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package functions
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// NewParseKubeconfig returns the parse_kubeconfig function
+func NewParseKubeconfig() function.Function {
+	return &ParseKubeconfig{}
+}
+
+// ParseKubeconfig extracts the connection details of a kube config
+type ParseKubeconfig struct{}
+
+var _ = function.Function(&ParseKubeconfig{})
+
+// Kubeconfig is the function's result model
+type Kubeconfig struct {
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	Token                types.String `tfsdk:"token"`
+}
+
+var kubeconfigType = map[string]attr.Type{
+	"host":                   types.StringType,
+	"cluster_ca_certificate": types.StringType,
+	"client_certificate":     types.StringType,
+	"client_key":             types.StringType,
+	"token":                  types.StringType,
+}
+
+// kubeconfig is the subset of a kube config file needed to connect to a cluster
+type kubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Contexts       []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Clusters []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// Metadata returns the function name
+func (f *ParseKubeconfig) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_kubeconfig"
+}
+
+// Definition returns the function signature
+func (f *ParseKubeconfig) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a kube config",
+		MarkdownDescription: "Returns the `host`, `cluster_ca_certificate`, `client_certificate`, `client_key` and `token` of the current context in a kube config, " +
+			"such as the `kube_config` of `stackit_kubernetes_cluster`. Certificates and keys are returned PEM encoded, missing values are returned as null",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "kube_config",
+				Description: "The kube config YAML",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: kubeconfigType,
+		},
+	}
+}
+
+// Run parses the kube config
+func (f *ParseKubeconfig) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var raw string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &raw))
+	if resp.Error != nil {
+		return
+	}
+
+	res, err := parseKubeconfig(raw)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, res))
+}
+
+func parseKubeconfig(raw string) (Kubeconfig, error) {
+	res := Kubeconfig{}
+	cfg := kubeconfig{}
+	if err := yaml.Unmarshal([]byte(raw), &cfg); err != nil {
+		return res, fmt.Errorf("failed to parse kube config: %w", err)
+	}
+	if len(cfg.Clusters) == 0 {
+		return res, fmt.Errorf("kube config doesn't contain any cluster")
+	}
+
+	// use the current context, or the first cluster and user if there's none
+	clusterName, userName := cfg.Clusters[0].Name, ""
+	if len(cfg.Users) > 0 {
+		userName = cfg.Users[0].Name
+	}
+	if cfg.CurrentContext != "" {
+		found := false
+		for _, c := range cfg.Contexts {
+			if c.Name == cfg.CurrentContext {
+				clusterName, userName, found = c.Context.Cluster, c.Context.User, true
+				break
+			}
+		}
+		if !found {
+			return res, fmt.Errorf("current context '%s' isn't defined in the kube config", cfg.CurrentContext)
+		}
+	}
+
+	found := false
+	for _, c := range cfg.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		found = true
+		ca, err := decodeData(c.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return res, fmt.Errorf("cluster '%s': invalid certificate-authority-data: %w", c.Name, err)
+		}
+		res.Host = stringOrNull(c.Cluster.Server)
+		res.ClusterCACertificate = ca
+	}
+	if !found {
+		return res, fmt.Errorf("cluster '%s' isn't defined in the kube config", clusterName)
+	}
+
+	res.ClientCertificate = types.StringNull()
+	res.ClientKey = types.StringNull()
+	res.Token = types.StringNull()
+	for _, u := range cfg.Users {
+		if u.Name != userName {
+			continue
+		}
+		cert, err := decodeData(u.User.ClientCertificateData)
+		if err != nil {
+			return res, fmt.Errorf("user '%s': invalid client-certificate-data: %w", u.Name, err)
+		}
+		key, err := decodeData(u.User.ClientKeyData)
+		if err != nil {
+			return res, fmt.Errorf("user '%s': invalid client-key-data: %w", u.Name, err)
+		}
+		res.ClientCertificate = cert
+		res.ClientKey = key
+		res.Token = stringOrNull(u.User.Token)
+	}
+	return res, nil
+}
+
+func decodeData(s string) (types.String, error) {
+	if s == "" {
+		return types.StringNull(), nil
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(string(b)), nil
+}
+
+func stringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
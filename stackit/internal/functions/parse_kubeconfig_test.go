@@ -0,0 +1,115 @@
+package functions
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testKubeconfig = fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: shoot
+clusters:
+- name: other
+  cluster:
+    server: https://other.example.com
+- name: shoot
+  cluster:
+    server: https://api.example.com
+    certificate-authority-data: %s
+contexts:
+- name: shoot
+  context:
+    cluster: shoot
+    user: admin
+users:
+- name: admin
+  user:
+    client-certificate-data: %s
+    client-key-data: %s
+`,
+	base64.StdEncoding.EncodeToString([]byte("ca")),
+	base64.StdEncoding.EncodeToString([]byte("cert")),
+	base64.StdEncoding.EncodeToString([]byte("key")),
+)
+
+func TestParseKubeconfig(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name    string
+		config  string
+		want    Kubeconfig
+		wantErr bool
+	}{
+		{"current context", testKubeconfig, Kubeconfig{
+			Host:                 types.StringValue("https://api.example.com"),
+			ClusterCACertificate: types.StringValue("ca"),
+			ClientCertificate:    types.StringValue("cert"),
+			ClientKey:            types.StringValue("key"),
+			Token:                types.StringNull(),
+		}, false},
+		{"no context", "clusters:\n- name: a\n  cluster:\n    server: https://a.example.com\nusers:\n- name: a\n  user:\n    token: abc\n", Kubeconfig{
+			Host:                 types.StringValue("https://a.example.com"),
+			ClusterCACertificate: types.StringNull(),
+			ClientCertificate:    types.StringNull(),
+			ClientKey:            types.StringNull(),
+			Token:                types.StringValue("abc"),
+		}, false},
+		{"unknown context", "current-context: x\nclusters:\n- name: a\n", Kubeconfig{}, true},
+		{"no clusters", "users: []\n", Kubeconfig{}, true},
+		{"invalid yaml", "clusters: [", Kubeconfig{}, true},
+		{"invalid ca", "clusters:\n- name: a\n  cluster:\n    certificate-authority-data: '!!'\n", Kubeconfig{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseKubeconfig(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseKubeconfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("parseKubeconfig() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseKubeconfigRun(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(testKubeconfig)}),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(kubeconfigType)),
+	}
+	NewParseKubeconfig().Run(ctx, req, &resp)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+	res := resp.Result.Value().(types.Object).Attributes()
+	if res["host"].(types.String).ValueString() != "https://api.example.com" {
+		t.Errorf("unexpected host %v", res["host"])
+	}
+
+	req.Arguments = function.NewArgumentsData([]attr.Value{types.StringValue("clusters: [")})
+	NewParseKubeconfig().Run(ctx, req, &resp)
+	if resp.Error == nil {
+		t.Error("expected an error for an invalid kube config")
+	}
+}
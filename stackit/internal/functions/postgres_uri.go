@@ -0,0 +1,93 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// NewPostgresURI returns the postgres_uri function
+func NewPostgresURI() function.Function {
+	return &PostgresURI{}
+}
+
+// PostgresURI builds a postgres connection URI
+type PostgresURI struct{}
+
+var _ = function.Function(&PostgresURI{})
+
+// Metadata returns the function name
+func (f *PostgresURI) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "postgres_uri"
+}
+
+// Definition returns the function signature
+func (f *PostgresURI) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a postgres connection URI",
+		MarkdownDescription: "Returns a `postgresql://` connection URI, with the username and password escaped. " +
+			"Can be used with the attributes of `stackit_postgres_flex_user` or the postgres `stackit_postgres_credential`",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "host",
+				Description: "The database host",
+			},
+			function.Int64Parameter{
+				Name:        "port",
+				Description: "The database port",
+			},
+			function.StringParameter{
+				Name:        "username",
+				Description: "The username",
+			},
+			function.StringParameter{
+				Name:        "password",
+				Description: "The password",
+			},
+			function.StringParameter{
+				Name:        "database",
+				Description: "The database name",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the URI
+func (f *PostgresURI) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host, username, password, database string
+	var port int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &host, &port, &username, &password, &database))
+	if resp.Error != nil {
+		return
+	}
+
+	uri, err := postgresURI(host, port, username, password, database)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, uri))
+}
+
+func postgresURI(host string, port int64, username, password, database string) (string, error) {
+	if host == "" {
+		return "", fmt.Errorf("host can't be empty")
+	}
+	if port < 1 || port > 65535 {
+		return "", fmt.Errorf("port %d is out of range [1 .. 65535]", port)
+	}
+	u := url.URL{
+		Scheme: "postgresql",
+		Host:   net.JoinHostPort(host, strconv.FormatInt(port, 10)),
+		Path:   "/" + database,
+	}
+	if username != "" {
+		u.User = url.UserPassword(username, password)
+	}
+	return u.String(), nil
+}
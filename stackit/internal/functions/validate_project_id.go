@@ -0,0 +1,49 @@
+package functions
+
+import (
+	"context"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// NewValidateProjectID returns the validate_project_id function
+func NewValidateProjectID() function.Function {
+	return &ValidateProjectID{}
+}
+
+// ValidateProjectID checks if a string is a valid project ID
+type ValidateProjectID struct{}
+
+var _ = function.Function(&ValidateProjectID{})
+
+// Metadata returns the function name
+func (f *ValidateProjectID) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_project_id"
+}
+
+// Definition returns the function signature
+func (f *ValidateProjectID) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Validate a project ID",
+		MarkdownDescription: "Returns `true` if the given value is a valid STACKIT project ID. Useful in variable `validation` blocks",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "project_id",
+				Description: "The project ID to validate",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run validates the project ID
+func (f *ValidateProjectID) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var projectID string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &projectID))
+	if resp.Error != nil {
+		return
+	}
+	valid := clientValidate.ProjectID(projectID) == nil
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, valid))
+}
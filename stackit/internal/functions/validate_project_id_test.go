@@ -0,0 +1,46 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateProjectID(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name      string
+		projectID string
+		want      bool
+	}{
+		{"valid", "7f3bd7e5-fd7a-4b87-a1e4-2c5d0e7bcd40", true},
+		{"invalid", "my-project", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.projectID)}),
+			}
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.BoolUnknown()),
+			}
+			NewValidateProjectID().Run(context.Background(), req, &resp)
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+			if got := resp.Result.Value().(types.Bool).ValueBool(); got != tt.want {
+				t.Errorf("validate_project_id(%q) = %v, want %v", tt.projectID, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Package iam is a client of the STACKIT service account API, which isn't covered by the community client
+// requests are sent through the authenticated base client of the provider
+package iam
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
+)
+
+// BaseURLs of the service account API, `STACKIT_SERVICE_ACCOUNT_BASEURL` overrides the default
+var BaseURLs = baseurl.New("service_account", "https://service-account.api.stackit.cloud")
+
+// Doer sends authenticated requests, it's implemented by the base client of the community client
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client of the service account API
+type Client struct {
+	doer    Doer
+	baseURL string
+}
+
+// Error is returned for unexpected response status codes
+type Error struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("service account API returned %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Body)
+}
+
+// IsNotFound reports if the error is caused by a missing resource
+func IsNotFound(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
+// New returns a client sending requests with doer to baseURL
+func New(doer Doer, baseURL string) *Client {
+	return &Client{doer: doer, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// do sends the request and decodes the response into out, if it's set
+// the raw response body is returned, e.g. to pass created keys on unchanged
+func (c *Client) do(ctx context.Context, method string, body interface{}, out interface{}, segments ...string) ([]byte, error) {
+	escaped := make([]string, len(segments))
+	for i, s := range segments {
+		escaped[i] = url.PathEscape(s)
+	}
+
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+"/v2/"+strings.Join(escaped, "/"), reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, &Error{StatusCode: res.StatusCode, Body: strings.TrimSpace(string(b))}
+	}
+	if out != nil {
+		if err := json.Unmarshal(b, out); err != nil {
+			return nil, fmt.Errorf("failed decoding response: %w", err)
+		}
+	}
+	return b, nil
+}
@@ -0,0 +1,58 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+func TestClient(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	const keyJSON = `{"id":"k1","active":true,"credentials":{"kid":"k1","iss":"sa@example.com","sub":"s1","aud":"https://stackit-service-account-prod.apps.01.cf.eu01.stackit.cloud","privateKey":"pem"}}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "GET /v2/projects/p1/service-accounts":
+			fmt.Fprint(w, `{"items":[{"id":"s1","email":"sa@example.com","projectId":"p1"}]}`)
+		case "POST /v2/projects/p1/service-accounts/sa@example.com/keys":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, keyJSON)
+		case "DELETE /v2/projects/p1/service-accounts/sa@example.com/keys/k1":
+			http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer srv.Close()
+
+	c := New(&http.Client{}, srv.URL+"/")
+	ctx := context.Background()
+
+	sa, err := c.GetServiceAccount(ctx, "p1", "sa@example.com")
+	if err != nil || sa.ID != "s1" {
+		t.Errorf("GetServiceAccount() = %v, %v", sa, err)
+	}
+	if _, err := c.GetServiceAccount(ctx, "p1", "other@example.com"); !IsNotFound(err) {
+		t.Errorf("GetServiceAccount() error = %v, want not found", err)
+	}
+
+	key, raw, err := c.CreateKey(ctx, "p1", "sa@example.com", CreateKeyRequest{})
+	if err != nil || key.ID != "k1" || !key.Active {
+		t.Errorf("CreateKey() = %v, %v", key, err)
+	}
+	if string(raw) != keyJSON {
+		t.Errorf("CreateKey() should return the response unchanged, got %s", raw)
+	}
+
+	if err := c.DeleteKey(ctx, "p1", "sa@example.com", "k1"); !IsNotFound(err) {
+		t.Errorf("DeleteKey() error = %v, want not found", err)
+	}
+}
@@ -0,0 +1,125 @@
+package iam
+
+import (
+	"context"
+	"net/http"
+)
+
+// ServiceAccount of a project
+type ServiceAccount struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	ProjectID string `json:"projectId"`
+}
+
+// Key of a service account
+type Key struct {
+	ID           string `json:"id"`
+	Active       bool   `json:"active"`
+	CreatedAt    string `json:"createdAt"`
+	ValidUntil   string `json:"validUntil,omitempty"`
+	KeyType      string `json:"keyType"`
+	KeyOrigin    string `json:"keyOrigin"`
+	KeyAlgorithm string `json:"keyAlgorithm"`
+	PublicKey    string `json:"publicKey"`
+}
+
+// CreateKeyRequest creates a key, STACKIT generates the key pair if PublicKey is empty
+type CreateKeyRequest struct {
+	PublicKey  string `json:"publicKey,omitempty"`
+	ValidUntil string `json:"validUntil,omitempty"`
+}
+
+// AccessToken of a service account, Token is only returned on creation
+type AccessToken struct {
+	ID         string `json:"id"`
+	Token      string `json:"token,omitempty"`
+	Active     bool   `json:"active"`
+	CreatedAt  string `json:"createdAt"`
+	ValidUntil string `json:"validUntil"`
+}
+
+// CreateServiceAccount creates a service account in the project
+func (c *Client) CreateServiceAccount(ctx context.Context, projectID, name string) (ServiceAccount, error) {
+	var sa ServiceAccount
+	_, err := c.do(ctx, http.MethodPost, map[string]string{"name": name}, &sa, "projects", projectID, "service-accounts")
+	return sa, err
+}
+
+// ListServiceAccounts lists the service accounts of the project
+func (c *Client) ListServiceAccounts(ctx context.Context, projectID string) ([]ServiceAccount, error) {
+	var res struct {
+		Items []ServiceAccount `json:"items"`
+	}
+	_, err := c.do(ctx, http.MethodGet, nil, &res, "projects", projectID, "service-accounts")
+	return res.Items, err
+}
+
+// GetServiceAccount returns the service account with the email, the API has no endpoint for a single service account
+func (c *Client) GetServiceAccount(ctx context.Context, projectID, email string) (ServiceAccount, error) {
+	items, err := c.ListServiceAccounts(ctx, projectID)
+	if err != nil {
+		return ServiceAccount{}, err
+	}
+	for _, sa := range items {
+		if sa.Email == email {
+			return sa, nil
+		}
+	}
+	return ServiceAccount{}, &Error{StatusCode: http.StatusNotFound, Body: "service account " + email + " not found"}
+}
+
+// DeleteServiceAccount deletes the service account with the email
+func (c *Client) DeleteServiceAccount(ctx context.Context, projectID, email string) error {
+	_, err := c.do(ctx, http.MethodDelete, nil, nil, "projects", projectID, "service-accounts", email)
+	return err
+}
+
+// CreateKey creates a key and returns the raw response, which is the key file consumed by the key flow
+func (c *Client) CreateKey(ctx context.Context, projectID, email string, body CreateKeyRequest) (Key, []byte, error) {
+	var key Key
+	raw, err := c.do(ctx, http.MethodPost, body, &key, "projects", projectID, "service-accounts", email, "keys")
+	return key, raw, err
+}
+
+// GetKey returns the key without credentials
+func (c *Client) GetKey(ctx context.Context, projectID, email, keyID string) (Key, error) {
+	var key Key
+	_, err := c.do(ctx, http.MethodGet, nil, &key, "projects", projectID, "service-accounts", email, "keys", keyID)
+	return key, err
+}
+
+// DeleteKey deletes the key
+func (c *Client) DeleteKey(ctx context.Context, projectID, email, keyID string) error {
+	_, err := c.do(ctx, http.MethodDelete, nil, nil, "projects", projectID, "service-accounts", email, "keys", keyID)
+	return err
+}
+
+// CreateAccessToken creates an access token that's valid for ttlDays
+func (c *Client) CreateAccessToken(ctx context.Context, projectID, email string, ttlDays int64) (AccessToken, error) {
+	var token AccessToken
+	_, err := c.do(ctx, http.MethodPost, map[string]int64{"ttlDays": ttlDays}, &token, "projects", projectID, "service-accounts", email, "access-tokens")
+	return token, err
+}
+
+// GetAccessToken returns the access token without its value, the API has no endpoint for a single token
+func (c *Client) GetAccessToken(ctx context.Context, projectID, email, tokenID string) (AccessToken, error) {
+	var res struct {
+		Items []AccessToken `json:"items"`
+	}
+	if _, err := c.do(ctx, http.MethodGet, nil, &res, "projects", projectID, "service-accounts", email, "access-tokens"); err != nil {
+		return AccessToken{}, err
+	}
+	for _, t := range res.Items {
+		if t.ID == tokenID {
+			return t, nil
+		}
+	}
+	return AccessToken{}, &Error{StatusCode: http.StatusNotFound, Body: "access token " + tokenID + " not found"}
+}
+
+// DeleteAccessToken revokes the access token
+func (c *Client) DeleteAccessToken(ctx context.Context, projectID, email, tokenID string) error {
+	_, err := c.do(ctx, http.MethodDelete, nil, nil, "projects", projectID, "service-accounts", email, "access-tokens", tokenID)
+	return err
+}
@@ -0,0 +1,29 @@
+// Package providerdata holds the client the provider hands to resources and data sources when it's configured
+// each provider alias has its own client, so cached lookups are never shared between differently configured aliases
+package providerdata
+
+import (
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iam"
+)
+
+// Client is the configured provider client
+type Client struct {
+	*services.Services
+
+	// Cache holds catalog lookups and service enablements for the lifetime of the provider
+	Cache *cache.Cache
+
+	// IAM is the client of the service account API, which isn't covered by services
+	IAM *iam.Client
+}
+
+// New returns the client of a provider configured with s and the service account client iamClient
+func New(s *services.Services, iamClient *iam.Client) *Client {
+	return &Client{
+		Services: s,
+		Cache:    cache.New(cache.DefaultTTL),
+		IAM:      iamClient,
+	}
+}
@@ -2,14 +2,11 @@ package credential
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -94,32 +91,5 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 // ImportState handles terraform import
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,instance_id,username`.\nInstead got: %q", req.ID),
-		)
-		return
-	}
-
-	// validate project id
-	if err := clientValidate.ProjectID(idParts[0]); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
-		return
-	}
-
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[2])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), idParts[2])...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("instance_id"), common.ImportString("username", "id", "username"))
 }
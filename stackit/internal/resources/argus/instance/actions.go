@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	grafanaConfigs "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/grafana-configs"
@@ -13,6 +12,7 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/wait"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -39,8 +39,10 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
-	// artificial wait for instance to be ready
-	time.Sleep(1 * time.Minute)
+	r.waitForConfigs(ctx, &resp.Diagnostics, &plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	r.setGrafanaConfig(ctx, &resp.Diagnostics, &plan, nil)
 	if resp.Diagnostics.HasError() {
@@ -98,6 +100,35 @@ func (r Resource) createInstance(ctx context.Context, diags *diag.Diagnostics, p
 	updateByAPIResult(plan, got)
 }
 
+// waitForConfigs waits until the config endpoints of a new instance are available
+func (r Resource) waitForConfigs(ctx context.Context, diags *diag.Diagnostics, plan *Instance) {
+	if plan.Grafana == nil && plan.Metrics == nil {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, 1*time.Hour)
+	if diags.Append(d...); diags.HasError() {
+		return
+	}
+
+	c := r.client.Argus
+	projectID, instanceID := plan.ProjectID.ValueString(), plan.ID.ValueString()
+	err := wait.Until(ctx, fmt.Sprintf("configs of argus instance %s", instanceID), timeout, func(ctx context.Context) (bool, error) {
+		gc, err := c.GrafanaConfigs.List(ctx, projectID, instanceID)
+		if agg := clientValidate.Response(gc, err, "JSON200"); agg != nil {
+			return false, wait.Retry(agg)
+		}
+		msr, err := c.MetricsStorageRetention.List(ctx, projectID, instanceID)
+		if agg := clientValidate.Response(msr, err, "JSON200"); agg != nil {
+			return false, wait.Retry(agg)
+		}
+		return true, nil
+	})
+	if err != nil {
+		diags.AddError("instance configs aren't available", err.Error())
+	}
+}
+
 func checkStatus(ctx context.Context, diags *diag.Diagnostics, instance *instances.ClientWithResponses, projectID, instanceID string, wantStatus ...instances.ProjectInstanceUIStatus) error {
 	res, err := instance.Get(ctx, projectID, instanceID)
 	if err := common.Validate(diags, res, err, "JSON200"); err == nil {
@@ -188,6 +219,8 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
+	common.ReadDeletionProtection(&state.DeletionProtection)
+
 	r.readInstance(ctx, &resp.Diagnostics, &state)
 	if resp.Diagnostics.HasError() {
 		return
@@ -387,6 +420,10 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		return
 	}
 
+	if common.DeletionProtected(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+
 	if state.ID.IsUnknown() || state.ID.IsNull() {
 		resp.Diagnostics.AddError("can't perform deletion", "argus instance id is unknown or null")
 	}
@@ -412,31 +449,29 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 	resp.State.RemoveResource(ctx)
 }
 
+// ImportParts returns the parts of the import identifier `project_id,id|name`
+func ImportParts() []common.ImportPart {
+	return []common.ImportPart{
+		common.ImportProjectID(),
+		common.ImportIDOrName("id"),
+	}
+}
+
 // ImportState handles terraform import
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,id` where `id` is the instance id.\nInstead got: %q", req.ID),
-		)
+	id, ok := common.ImportState(ctx, req, resp, ImportParts()...)
+	if !ok {
 		return
 	}
-	projectID := idParts[0]
-	instanceID := idParts[1]
 
-	// validate project id
-	if err := clientValidate.ProjectID(projectID); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
-		return
+	projectID, instanceID := id.Get("project_id"), id.Get("id")
+	if id.IsName("id") {
+		var err error
+		if instanceID, err = r.instanceIDByName(ctx, &resp.Diagnostics, projectID, instanceID); err != nil {
+			resp.Diagnostics.AddError("failed to find instance by name", err.Error())
+			return
+		}
 	}
-
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), instanceID)...)
 
 	if resp.Diagnostics.HasError() {
@@ -7,6 +7,9 @@ import (
 	"strings"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/plans"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,11 +23,13 @@ const (
 )
 
 func (r Resource) loadPlanID(ctx context.Context, diags *diag.Diagnostics, s *Instance) {
-	c := r.client.Argus
-
-	res, err := c.Plans.ListPlans(ctx, s.ProjectID.ValueString())
-	if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
-		diags.AddError("failed to list argus plans", agg.Error())
+	projectID := s.ProjectID.ValueString()
+	res, err := cache.Load(ctx, r.client.Cache, cache.Key("argus/plans", projectID), func(ctx context.Context) (*plans.ListPlansResponse, error) {
+		res, err := r.client.Argus.Plans.ListPlans(ctx, projectID)
+		return res, validate.Response(res, err, "JSON200")
+	})
+	if err != nil {
+		diags.AddError("failed to list argus plans", err.Error())
 		return
 	}
 
@@ -88,3 +93,19 @@ func transformDayMetric(days interface{}) int64 {
 	r, _ := strconv.Atoi(t)
 	return int64(r)
 }
+
+// instanceIDByName returns the ID of the instance called name
+func (r Resource) instanceIDByName(ctx context.Context, diags *diag.Diagnostics, projectID, name string) (string, error) {
+	res, err := r.client.Argus.Instances.List(ctx, projectID)
+	if agg := common.Validate(diags, res, err, "JSON200.Instances"); agg != nil {
+		return "", agg
+	}
+
+	items := res.JSON200.Instances
+	return common.IDByName("instance", name, len(items), func(i int) (string, string) {
+		if items[i].Name == nil {
+			return "", ""
+		}
+		return *items[i].Name, items[i].ID
+	})
+}
@@ -43,6 +43,7 @@ type Instance struct {
 	OtlpTracesHttpURL           types.String   `tfsdk:"otlp_traces_http_url"`
 	OtlpTracesGRPCUrl           types.String   `tfsdk:"otlp_traces_grpc_url"`
 	ZipkinSpansURL              types.String   `tfsdk:"zipkin_spans_url"`
+	DeletionProtection          types.Bool     `tfsdk:"deletion_protection"`
 	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
 }
 
@@ -89,7 +90,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					validate.ProjectID(),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					common.ReplaceUnlessProtected{},
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
@@ -137,6 +138,8 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				},
 			},
 
+			"deletion_protection": common.DeletionProtection("instance"),
+
 			"timeouts": common.Timeouts(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
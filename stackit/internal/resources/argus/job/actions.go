@@ -2,12 +2,8 @@ package job
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
 	scrapeconfig "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/scrape-config"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
@@ -134,31 +130,5 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 // ImportState handles terraform import
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,id,name` where `id` is the instance id and `name` is the job name.\nInstead got: %q", req.ID),
-		)
-		return
-	}
-
-	projectID := idParts[0]
-	instanceID := idParts[1]
-	name := idParts[2]
-
-	// validate project id
-	if err := clientValidate.ProjectID(projectID); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
-		return
-	}
-
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("argus_instance_id"), instanceID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("argus_instance_id"), common.ImportString("name"))
 }
@@ -2,15 +2,14 @@ package credential
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/credentials"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/wait"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -24,18 +23,27 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
+	// binding is retried until the create timeout while the instance isn't ready
+	timeout, d := cred.Timeouts.Create(ctx, 5*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
 	// handle creation
-	res, err := r.client.Credentials.Post(ctx, cred.ProjectID.ValueString(), cred.InstanceID.ValueString())
-	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
-		if res.Error != nil && strings.Contains(res.Error.Error(), "service bind failed") {
-			time.Sleep(30 * time.Second)
-			res, err = r.client.Credentials.Post(ctx, cred.ProjectID.ValueString(), cred.InstanceID.ValueString())
-			agg = common.Validate(&resp.Diagnostics, res, err, "JSON200")
-		}
-		if agg != nil {
-			diags.AddError("failed credential creation", agg.Error())
-			return
+	// binding fails for a while after the instance was created or updated
+	var res *credentials.PostResponse
+	err := wait.Until(ctx, "credential binding", timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		res, err = r.client.Credentials.Post(ctx, cred.ProjectID.ValueString(), cred.InstanceID.ValueString())
+		if agg := validate.Response(res, err, "JSON200"); agg != nil && res != nil && res.Error != nil && strings.Contains(res.Error.Error(), "service bind failed") {
+			return false, wait.Retry(agg)
 		}
+		agg := common.Validate(&resp.Diagnostics, res, err, "JSON200")
+		return agg == nil, agg
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed credential creation", err.Error())
+		return
 	}
 
 	// check for some buggy scenarios from bad API Responses
@@ -119,31 +127,5 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 // ImportState handles terraform import
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,instance_id,credential_id`.\nInstead got: %q", req.ID),
-		)
-		return
-	}
-
-	// validate project id
-	if err := clientValidate.ProjectID(idParts[0]); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
-		return
-	}
-
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[2])...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("instance_id"), common.ImportString("credential_id", "id"))
 }
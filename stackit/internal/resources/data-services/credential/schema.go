@@ -6,6 +6,7 @@ import (
 
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -16,19 +17,20 @@ import (
 
 // Credential is the schema model
 type Credential struct {
-	ID              types.String `tfsdk:"id"`
-	ProjectID       types.String `tfsdk:"project_id"`
-	InstanceID      types.String `tfsdk:"instance_id"`
-	Host            types.String `tfsdk:"host"`
-	Hosts           types.List   `tfsdk:"hosts"`
-	DatabaseName    types.String `tfsdk:"database_name"`
-	Username        types.String `tfsdk:"username"`
-	Password        types.String `tfsdk:"password"`
-	Port            types.Int64  `tfsdk:"port"`
-	SyslogDrainURL  types.String `tfsdk:"syslog_drain_url"`
-	RouteServiceURL types.String `tfsdk:"route_service_url"`
-	URI             types.String `tfsdk:"uri"`
-	RawResponse     types.String `tfsdk:"raw_response"`
+	ID              types.String   `tfsdk:"id"`
+	ProjectID       types.String   `tfsdk:"project_id"`
+	InstanceID      types.String   `tfsdk:"instance_id"`
+	Host            types.String   `tfsdk:"host"`
+	Hosts           types.List     `tfsdk:"hosts"`
+	DatabaseName    types.String   `tfsdk:"database_name"`
+	Username        types.String   `tfsdk:"username"`
+	Password        types.String   `tfsdk:"password"`
+	Port            types.Int64    `tfsdk:"port"`
+	SyslogDrainURL  types.String   `tfsdk:"syslog_drain_url"`
+	RouteServiceURL types.String   `tfsdk:"route_service_url"`
+	URI             types.String   `tfsdk:"uri"`
+	RawResponse     types.String   `tfsdk:"raw_response"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Schema returns the terraform schema structure
@@ -119,6 +121,10 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:    true,
 				Sensitive:   true,
 			},
+
+			"timeouts": common.Timeouts(ctx, timeouts.Opts{
+				Create: true,
+			}),
 		},
 	}
 }
@@ -9,8 +9,8 @@ import (
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/instances"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/wait"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -27,7 +27,7 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	}
 
 	// validate
-	if err := r.validate(ctx, &resp.Diagnostics, &plan); err != nil {
+	if err := r.validate(ctx, &plan); err != nil {
 		resp.Diagnostics.AddError("failed instance validation", err.Error())
 		return
 	}
@@ -112,6 +112,9 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	common.ReadDeletionProtection(&state.DeletionProtection)
+
 	// read instance
 	res, err := r.client.Instances.Get(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
 	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
@@ -151,7 +154,7 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 	}
 
 	// validate
-	if err := r.validate(ctx, &resp.Diagnostics, &plan); err != nil {
+	if err := r.validate(ctx, &plan); err != nil {
 		resp.Diagnostics.AddError("failed validation", err.Error())
 		return
 	}
@@ -192,11 +195,18 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 	}
 
 	// mitigate an API bug that returns old data after an update completed
-	time.Sleep(1 * time.Minute)
-
-	newRes, err := r.client.Instances.Get(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
-	if agg := common.Validate(&resp.Diagnostics, newRes, err, "JSON200"); agg != nil {
-		resp.Diagnostics.AddError("failed to read after update", agg.Error())
+	var newRes *instances.GetResponse
+	err = wait.Until(ctx, fmt.Sprintf("update of instance %s to be visible", state.ID.ValueString()), timeout, func(ctx context.Context) (bool, error) {
+		var err error
+		newRes, err = r.client.Instances.Get(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+		if agg := validate.Response(newRes, err, "JSON200"); agg != nil {
+			return false, wait.Retry(agg)
+		}
+		current, _ := newRes.JSON200.Parameters["sgw_acl"].(string)
+		return newRes.JSON200.PlanID == body.PlanID && sameACL(current, aclString), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read after update", err.Error())
 		return
 	}
 	if err := r.applyClientResponse(ctx, &plan, newRes.JSON200); err != nil {
@@ -219,6 +229,10 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		return
 	}
 
+	if common.DeletionProtected(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+
 	// handle deletion
 	res, err := r.client.Instances.Deprovision(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
 	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
@@ -243,28 +257,31 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 }
 
+// ImportParts returns the parts of the import identifier `project_id,instance_id|name`
+func ImportParts() []common.ImportPart {
+	return []common.ImportPart{
+		common.ImportProjectID(),
+		common.ImportIDOrName("instance_id"),
+	}
+}
+
 // ImportState handles terraform import
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,instance_id`.\nInstead got: %q", req.ID),
-		)
+	id, ok := common.ImportState(ctx, req, resp, ImportParts()...)
+	if !ok {
 		return
 	}
 
-	// validate project id
-	if err := clientValidate.ProjectID(idParts[0]); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
-		return
+	projectID, instanceID := id.Get("project_id"), id.Get("instance_id")
+	if id.IsName("instance_id") {
+		var err error
+		if instanceID, err = r.instanceIDByName(ctx, &resp.Diagnostics, projectID, instanceID); err != nil {
+			resp.Diagnostics.AddError("failed to find instance by name", err.Error())
+			return
+		}
 	}
 
-	plan, version, err := r.getPlanAndVersion(ctx, &resp.Diagnostics, idParts[0], idParts[1])
+	plan, version, err := r.getPlanAndVersion(ctx, &resp.Diagnostics, projectID, instanceID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error during import",
@@ -273,8 +290,7 @@ func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequ
 		return
 	}
 	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), instanceID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("plan"), plan)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), version)...)
 
@@ -3,10 +3,14 @@ package instance
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/instances"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/offerings"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -54,14 +58,14 @@ func (r Resource) getDefaultPlan() string {
 	return ""
 }
 
-func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data *Instance) error {
+func (r Resource) validate(ctx context.Context, data *Instance) error {
 	if !data.ACL.IsUnknown() && len(data.ACL.Elements()) == 0 {
 		return errors.New("at least 1 ip address must be specified for `acl`")
 	}
 
-	res, err := r.client.Offerings.List(ctx, data.ProjectID.ValueString())
-	if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
-		return agg
+	res, err := r.listOfferings(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		return err
 	}
 
 	if err := r.validateVersion(ctx, res.JSON200.Offerings, data.Version.ValueString()); err != nil {
@@ -76,6 +80,14 @@ func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data *I
 	return nil
 }
 
+// listOfferings returns the offerings of the service, which are shared by all instances of the provider
+func (r Resource) listOfferings(ctx context.Context, projectID string) (*offerings.ListResponse, error) {
+	return cache.Load(ctx, r.cache, cache.Key(string(r.service)+"/offerings", projectID), func(ctx context.Context) (*offerings.ListResponse, error) {
+		res, err := r.client.Offerings.List(ctx, projectID)
+		return res, validate.Response(res, err, "JSON200")
+	})
+}
+
 func (r Resource) validateVersion(ctx context.Context, offers []offerings.Offering, version string) error {
 	opts := []string{}
 	for _, offer := range offers {
@@ -130,15 +142,36 @@ func (r Resource) applyClientResponse(ctx context.Context, pi *Instance, i *inst
 	return nil
 }
 
+// sameACL compares comma separated ACLs regardless of their order and of how the CIDRs are written
+func sameACL(a, b string) bool {
+	return reflect.DeepEqual(canonicalACL(a), canonicalACL(b))
+}
+
+// canonicalACL returns the sorted, distinct canonical CIDRs of a comma separated ACL
+func canonicalACL(acl string) []string {
+	seen := map[string]bool{}
+	out := []string{}
+	for _, cidr := range strings.Split(acl, ",") {
+		cidr = common.CanonicalCIDR(strings.TrimSpace(cidr))
+		if cidr == "" || seen[cidr] {
+			continue
+		}
+		seen[cidr] = true
+		out = append(out, cidr)
+	}
+	sort.Strings(out)
+	return out
+}
+
 func (r Resource) getPlanAndVersion(ctx context.Context, diags *diag.Diagnostics, projectID, instanceID string) (plan, version string, err error) {
 	i, err := r.client.Instances.Get(ctx, projectID, instanceID)
 	if agg := common.Validate(diags, i, err, "JSON200"); agg != nil {
 		return "", "", agg
 	}
 
-	res, err := r.client.Offerings.List(ctx, projectID)
-	if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
-		return "", "", agg
+	res, err := r.listOfferings(ctx, projectID)
+	if err != nil {
+		return "", "", err
 	}
 
 	for _, offer := range res.JSON200.Offerings {
@@ -152,3 +185,19 @@ func (r Resource) getPlanAndVersion(ctx context.Context, diags *diag.Diagnostics
 
 	return "", "", errors.Wrapf(err, "couldn't find plan ID %s", i.JSON200.PlanID)
 }
+
+// instanceIDByName returns the ID of the instance called name
+func (r Resource) instanceIDByName(ctx context.Context, diags *diag.Diagnostics, projectID, name string) (string, error) {
+	res, err := r.client.Instances.List(ctx, projectID)
+	if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
+		return "", agg
+	}
+
+	items := res.JSON200.Instances
+	return common.IDByName("instance", name, len(items), func(i int) (string, string) {
+		if items[i].InstanceID == nil {
+			return "", ""
+		}
+		return items[i].Name, *items[i].InstanceID
+	})
+}
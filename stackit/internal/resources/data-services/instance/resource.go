@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
-	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
 	dataservices "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/providerdata"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -102,6 +104,7 @@ func NewOpensearch() resource.Resource {
 // Resource is the exported resource
 type Resource struct {
 	client  *dataservices.ClientWithResponses
+	cache   *cache.Cache
 	service ResourceService
 	urls    baseurl.BaseURL
 }
@@ -120,12 +123,12 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	c, ok := req.ProviderData.(*services.Services)
+	c, ok := req.ProviderData.(*providerdata.Client)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *services.Services, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -173,12 +176,17 @@ func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReques
 
 	if stateVersion.Segments()[0] != planVersion.Segments()[0] {
 		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("version"))
+		common.PreventProtectedReplacement(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		resp.Diagnostics.AddAttributeWarning(path.Root("version"), "Changing Version on RabbitMQ require replacement", "Changing Version on RabbitMQ require replacement")
 	}
 
 }
 
-func (r *Resource) setClient(c *services.Services) {
+func (r *Resource) setClient(c *providerdata.Client) {
+	r.cache = c.Cache
 	switch r.service {
 	case ElasticSearch:
 		r.client = c.ElasticSearch
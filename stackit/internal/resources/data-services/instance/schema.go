@@ -29,6 +29,7 @@ type Instance struct {
 	CFGUID             types.String   `tfsdk:"cf_guid"`
 	CFSpaceGUID        types.String   `tfsdk:"cf_space_guid"`
 	CFOrganizationGUID types.String   `tfsdk:"cf_organization_guid"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
 	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
@@ -53,7 +54,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Description: "Specifies the instance name. Changing this value requires the resource to be recreated. Changing this value requires the resource to be recreated.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					common.ReplaceUnlessProtected{},
 				},
 			},
 			"project_id": schema.StringAttribute{
@@ -63,7 +64,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					validate.ProjectID(),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					common.ReplaceUnlessProtected{},
 				},
 			},
 			"plan": schema.StringAttribute{
@@ -105,6 +106,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Description: "Cloud Foundry Organization GUID",
 				Computed:    true,
 			},
+			"deletion_protection": common.DeletionProtection("instance"),
 			"timeouts": common.Timeouts(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
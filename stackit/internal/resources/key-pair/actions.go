@@ -0,0 +1,109 @@
+package keypair
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	iaas_keypair "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/keypair"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan KeyPair
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	res, err := r.client.IAAS.KeyPair.V1CreateKeyPair(ctx, iaas_keypair.V1CreateKeyPairJSONRequestBody{
+		Name:      &name,
+		PublicKey: strings.TrimSpace(plan.PublicKey.ValueString()),
+		Labels:    common.FromLabels(plan.Labels),
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed creating key pair", agg.Error())
+		return
+	}
+
+	plan.ID = plan.Name
+	plan.Fingerprint = types.StringPointerValue(res.JSON201.Fingerprint)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state KeyPair
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.IAAS.KeyPair.V1GetKeyPair(ctx, state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading key pair", agg.Error())
+		return
+	}
+
+	kp := res.JSON200
+	state.Name = state.ID
+	state.Fingerprint = types.StringPointerValue(kp.Fingerprint)
+	state.Labels = common.ToLabels(kp.Labels)
+	// the API may normalize the key, keep the configured value if it's the same key
+	if strings.TrimSpace(state.PublicKey.ValueString()) != strings.TrimSpace(kp.PublicKey) {
+		state.PublicKey = types.StringValue(kp.PublicKey)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan KeyPair
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.IAAS.KeyPair.V1UpdateKeyPair(ctx, plan.ID.ValueString(), iaas_keypair.V1UpdateKeyPairJSONRequestBody{
+		Labels: common.FromLabels(plan.Labels),
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		resp.Diagnostics.AddError("failed updating key pair", agg.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state KeyPair
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.IAAS.KeyPair.V1DeleteKeyPair(ctx, state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil && !validate.StatusEquals(res, http.StatusNotFound) {
+		resp.Diagnostics.AddError("failed deleting key pair", agg.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,92 @@
+package keypair_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_KeyPair(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name, publicKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_key_pair.example", "name", name),
+					resource.TestCheckResourceAttr("stackit_key_pair.example", "id", name),
+					resource.TestCheckResourceAttrSet("stackit_key_pair.example", "fingerprint"),
+				),
+			},
+			// check update
+			{
+				Config: configLabels(name, publicKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_key_pair.example", "labels.env", "test"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_key_pair.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_key_pair.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_key_pair.example")
+					}
+					return r.Primary.ID, nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const publicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIBgO7DSm0GGZUYBh6sOsWwGf2jrI0xIWWUCSDZX3RFp4 acc-test"
+
+func config(name, key string) string {
+	return fmt.Sprintf(`
+resource "stackit_key_pair" "example" {
+	name       = "%s"
+	public_key = "%s"
+}
+	  `,
+		name,
+		key,
+	)
+}
+
+func configLabels(name, key string) string {
+	return fmt.Sprintf(`
+resource "stackit_key_pair" "example" {
+	name       = "%s"
+	public_key = "%s"
+	labels = {
+		env = "test"
+	}
+}
+	  `,
+		name,
+		key,
+	)
+}
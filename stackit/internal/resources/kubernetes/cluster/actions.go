@@ -2,18 +2,16 @@ package cluster
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/credentials"
-	serviceenablement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-enablement/v1"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/enablement"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -86,43 +84,15 @@ func (r Resource) preProcessConfig(diags *diag.Diagnostics, cl *Cluster) {
 }
 
 func (r Resource) enableProject(ctx context.Context, diags *diag.Diagnostics, cl *Cluster, timeout time.Duration) {
-	projectID := cl.ProjectID.ValueString()
-	c := r.client.ServiceEnablement
-
-	serviceID := "cloud.stackit.ske"
-
-	found := true
-	status, err := c.GetService(ctx, projectID, serviceID)
-	if agg := common.Validate(&diag.Diagnostics{}, status, err, "JSON200.State"); agg != nil {
-		if status == nil || status.StatusCode() != http.StatusNotFound {
-			diags.AddError("failed to fetch SKE project status", agg.Error())
-			return
-		}
-		found = false
-	}
-
-	// check if project already enabled
-	if found && *status.JSON200.State == serviceenablement.ENABLED {
-		return
-	}
-
-	res, err := c.EnableService(ctx, projectID, serviceID)
-	if agg := common.Validate(diags, res, err); agg != nil {
-		diags.AddError("failed during SKE service enablement", agg.Error())
-		return
-	}
-
-	process := res.WaitHandler(ctx, c, projectID, serviceID).SetTimeout(timeout)
-	if _, err := process.WaitWithContext(ctx); err != nil {
-		diags.AddError("failed to verify SKE service enablement", err.Error())
-		return
+	if err := enablement.For(r.client).EnableService(ctx, cl.ProjectID.ValueString(), enablement.ServiceSKE, timeout); err != nil {
+		diags.AddError("failed during SKE service enablement", err.Error())
 	}
 }
 
 func (r Resource) createOrUpdateCluster(ctx context.Context, diags *diag.Diagnostics, cl *Cluster, timeout time.Duration) {
 	c := r.client
 
-	versions, err := r.loadAvaiableVersions(ctx, diags)
+	versions, err := r.loadAvaiableVersions(ctx)
 	if err != nil {
 		diags.AddError("failed while loading version options", err.Error())
 		return
@@ -160,7 +130,7 @@ func (r Resource) createOrUpdateCluster(ctx context.Context, diags *diag.Diagnos
 		return
 	}
 
-	if err := r.validate(ctx, diags, projectID, clusterName, clusterConfig, &nodePools, maintenance, hibernations, extensions); err != nil {
+	if err := r.validate(ctx, projectID, clusterName, clusterConfig, &nodePools, maintenance, hibernations, extensions); err != nil {
 		diags.AddError(
 			"Failed cluster validation",
 			err.Error(),
@@ -234,6 +204,8 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
+	common.ReadDeletionProtection(&state.DeletionProtection)
+
 	// pre process state
 	r.preProcessConfig(&resp.Diagnostics, &state)
 	if resp.Diagnostics.HasError() {
@@ -315,6 +287,10 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		return
 	}
 
+	if common.DeletionProtected(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+
 	// pre process plan
 	r.preProcessConfig(&resp.Diagnostics, &state)
 	if resp.Diagnostics.HasError() {
@@ -344,49 +320,24 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState handles terraform import
-func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `kubernetes_project_id,name` where `name` is the cluster name.\nInstead got: %q", req.ID),
-		)
-		return
+// ImportParts returns the parts of the import identifier `kubernetes_project_id,name`
+func ImportParts() []common.ImportPart {
+	return []common.ImportPart{
+		common.ImportProjectID("kubernetes_project_id", "project_id"),
+		{Name: "name", Attributes: []string{"name", "id"}, Validate: cluster.ValidateClusterName},
 	}
+}
 
-	// validate cluster name
-	if err := cluster.ValidateClusterName(idParts[1]); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate cluster name.\n%s", err.Error()),
-		)
-		return
-	}
-
-	// validate project id
-	if err := clientValidate.ProjectID(idParts[0]); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate kubernetes_project_id.\n%s", err.Error()),
-		)
-		return
-	}
-
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("kubernetes_project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
-
-	if resp.Diagnostics.HasError() {
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, ok := common.ImportState(ctx, req, resp, ImportParts()...)
+	if !ok {
 		return
 	}
 
 	// pre-read imports
 	c := r.client
-	res, err := c.Kubernetes.Cluster.Get(ctx, idParts[0], idParts[1])
+	res, err := c.Kubernetes.Cluster.Get(ctx, id.Get("kubernetes_project_id"), id.Get("name"))
 	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
 		resp.Diagnostics.AddError("failed import pre-read", agg.Error())
 		return
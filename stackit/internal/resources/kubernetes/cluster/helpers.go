@@ -6,8 +6,11 @@ import (
 
 	"github.com/Masterminds/semver"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	provideroptions "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/provider-options"
 	"github.com/pkg/errors"
 
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -29,12 +32,11 @@ const (
 	DefaultVersion                      = "1.31"
 )
 
-func (r Resource) loadAvaiableVersions(ctx context.Context, diags *diag.Diagnostics) ([]*semver.Version, error) {
-	c := r.client
+func (r Resource) loadAvaiableVersions(ctx context.Context) ([]*semver.Version, error) {
 	var versionOptions []*semver.Version
-	res, err := c.Kubernetes.ProviderOptions.List(ctx)
-	if agg := common.Validate(diags, res, err, "JSON200.KubernetesVersions"); agg != nil {
-		return nil, errors.Wrap(agg, "failed fetching cluster versions")
+	res, err := r.providerOptions(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed fetching cluster versions")
 	}
 
 	opts := res.JSON200
@@ -53,6 +55,14 @@ func (r Resource) loadAvaiableVersions(ctx context.Context, diags *diag.Diagnost
 	return versionOptions, nil
 }
 
+// providerOptions returns the SKE provider options, which are shared by all clusters of the provider
+func (r Resource) providerOptions(ctx context.Context) (*provideroptions.ListResponse, error) {
+	return cache.Load(ctx, r.client.Cache, cache.Key("kubernetes/provider-options"), func(ctx context.Context) (*provideroptions.ListResponse, error) {
+		res, err := r.client.Kubernetes.ProviderOptions.List(ctx)
+		return res, validate.Response(res, err, "JSON200.KubernetesVersions")
+	})
+}
+
 func (c *Cluster) clusterConfig(versionOptions []*semver.Version) (cluster.Kubernetes, error) {
 	if c.KubernetesVersion.IsNull() || c.KubernetesVersion.IsUnknown() {
 		c.KubernetesVersion = types.StringValue(DefaultVersion)
@@ -17,7 +17,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -37,6 +36,7 @@ type Cluster struct {
 	Extensions                *Extensions    `tfsdk:"extensions"`
 	Status                    types.String   `tfsdk:"status"`
 	KubeConfig                types.String   `tfsdk:"kube_config"`
+	DeletionProtection        types.Bool     `tfsdk:"deletion_protection"`
 	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
 	NetworkID                 types.String   `tfsdk:"network_id"`
 }
@@ -110,7 +110,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					validate.StringWith(cluster.ValidateClusterName, "validate cluster name"),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					common.ReplaceUnlessProtected{},
 				},
 			},
 			// TODO: remove in next releases
@@ -369,6 +369,8 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Optional:    false,
 			},
 
+			"deletion_protection": common.DeletionProtection("cluster"),
+
 			"timeouts": common.Timeouts(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -384,7 +386,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					validate.NetworkID(),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					common.ReplaceUnlessProtected{},
 				},
 			},
 		},
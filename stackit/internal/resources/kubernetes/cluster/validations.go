@@ -9,13 +9,10 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
 	provideroptions "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/provider-options"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 func (r Resource) validate(
 	ctx context.Context,
-	diags *diag.Diagnostics,
 	projectID string,
 	clusterName string,
 	clusterConfig cluster.Kubernetes,
@@ -31,10 +28,8 @@ func (r Resource) validate(
 	}
 
 	// Validate against real options
-	c := r.client
-	opts, err := c.Kubernetes.ProviderOptions.List(ctx)
-
-	if agg := common.Validate(diags, opts, err, "JSON200.KubernetesVersions"); agg != nil {
+	opts, err := r.providerOptions(ctx)
+	if err != nil {
 		// if options cannot be fetched, skip validation
 		return nil
 	}
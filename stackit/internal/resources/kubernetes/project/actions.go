@@ -8,7 +8,6 @@ import (
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -84,18 +83,5 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 // ImportState handles terraform import
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	if req.ID == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			"Expected import id to be set, got an empty string",
-		)
-		return
-	}
-
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	common.ImportState(ctx, req, resp, common.ImportProjectID("project_id", "id"))
 }
@@ -4,18 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
-	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/project"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/enablement"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // Create - lifecycle function
@@ -26,7 +27,7 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
-	r.enableProject(ctx, plan.ProjectID.ValueString(), &resp.Diagnostics)
+	EnableProject(ctx, r.client, plan.ProjectID.ValueString(), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -76,32 +77,10 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 
 }
 
-func (r Resource) isProjectReady(ctx context.Context, projectID string, diags *diag.Diagnostics) bool {
-	res, err := r.client.LoadBalancer.Project.GetStatus(ctx, projectID)
-	if agg := validate.Response(res, err, "JSON200.Status"); agg != nil {
-		diags.AddError("Couldn't get project status", agg.Error())
-		return false
-	}
-	if *res.JSON200.Status == project.STATUS_READY {
-		return true
-	}
-	return false
-}
-
-func (r Resource) enableProject(ctx context.Context, projectID string, diags *diag.Diagnostics) {
-	isReady := r.isProjectReady(ctx, projectID, diags)
-	if diags.HasError() || isReady {
-		return
-	}
-	res, err := r.client.LoadBalancer.Project.EnableProject(ctx, projectID, &project.EnableProjectParams{})
-	if agg := validate.Response(res, err); agg != nil {
-		diags.AddError("Couldn't enable project", agg.Error())
-		return
-	}
-	process := res.WaitHandler(ctx, r.client.LoadBalancer.Project, projectID)
-	if _, err := process.Wait(); err != nil {
-		diags.AddError("Received an error while waiting for project to be enabled", err.Error())
-		return
+// EnableProject enables the load balancer service for the project if it isn't ready yet
+func EnableProject(ctx context.Context, c *providerdata.Client, projectID string, diags *diag.Diagnostics) {
+	if err := enablement.For(c).EnableLoadBalancer(ctx, projectID); err != nil {
+		diags.AddError("Couldn't enable project", err.Error())
 	}
 }
 
@@ -141,7 +120,58 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 }
 
 // Update - lifecycle function
+// only changes to existing target pools are applied in place, all other attributes require replacement
 func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Instance
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Update(ctx, 1*time.Hour)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior := map[string]attr.Value{}
+	for _, v := range state.TargetPools.Elements() {
+		if obj, ok := v.(types.Object); ok {
+			prior[obj.Attributes()["name"].(types.String).ValueString()] = v
+		}
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	name := plan.Name.ValueString()
+	unlock := LockTargetPools(projectID, name)
+	defer unlock()
+
+	for _, v := range plan.TargetPools.Elements() {
+		var tp TargetPool
+		resp.Diagnostics.Append(v.(types.Object).As(ctx, &tp, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if p, ok := prior[tp.Name.ValueString()]; ok && p.Equal(v) {
+			continue
+		}
+		if _, err := UpdateTargetPool(ctx, r.client, projectID, name, tp, timeout); err != nil {
+			resp.Diagnostics.AddError("Couldn't update target pool", err.Error())
+			return
+		}
+	}
+
+	res, err := r.client.LoadBalancer.Instances.Get(ctx, projectID, name)
+	if agg := validate.Response(res, err, "JSON200.Name"); agg != nil {
+		resp.Diagnostics.AddError("Couldn't get instance information", agg.Error())
+		return
+	}
+
+	plan.parse(ctx, *res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 // Delete - lifecycle function
@@ -172,34 +202,15 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState handles terraform import
-func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,name`.\nInstead got: %q", req.ID),
-		)
-		return
-	}
-
-	// validate project id
-	if err := clientValidate.ProjectID(idParts[0]); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
-		return
-	}
-
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
-
-	if resp.Diagnostics.HasError() {
-		return
+// ImportParts returns the parts of the import identifier `project_id,name`
+func ImportParts() []common.ImportPart {
+	return []common.ImportPart{
+		common.ImportProjectID(),
+		common.ImportString("name", "id", "name"),
 	}
+}
 
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, ImportParts()...)
 }
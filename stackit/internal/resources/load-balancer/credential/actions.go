@@ -0,0 +1,118 @@
+package credential
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/credentials"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	loadbalancer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/load-balancer"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Credential
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	loadbalancer.EnableProject(ctx, r.client, plan.ProjectID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pw := password(ctx, req.Config, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := credentials.CreateJSONRequestBody{
+		DisplayName: plan.DisplayName.ValueStringPointer(),
+		Username:    plan.Username.ValueStringPointer(),
+		Password:    pw,
+	}
+	res, err := r.client.LoadBalancer.Credentials.Create(ctx, plan.ProjectID.ValueString(), &credentials.CreateParams{}, body)
+	if agg := validate.Response(res, err, "JSON200.Credential.CredentialsRef"); agg != nil {
+		resp.Diagnostics.AddError("Couldn't create load balancer credential", agg.Error())
+		return
+	}
+
+	plan.parse(*res.JSON200.Credential)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Credential
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.LoadBalancer.Credentials.Get(ctx, state.ProjectID.ValueString(), state.CredentialsRef.ValueString())
+	if agg := validate.Response(res, err, "JSON200.Credential"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Couldn't get load balancer credential", agg.Error())
+		return
+	}
+
+	state.parse(*res.JSON200.Credential)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Credential
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pw := password(ctx, req.Config, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := credentials.UpdateJSONRequestBody{
+		DisplayName: plan.DisplayName.ValueStringPointer(),
+		Username:    plan.Username.ValueStringPointer(),
+		Password:    pw,
+	}
+	res, err := r.client.LoadBalancer.Credentials.Update(ctx, state.ProjectID.ValueString(), state.CredentialsRef.ValueString(), body)
+	if agg := validate.Response(res, err, "JSON200.Credential"); agg != nil {
+		resp.Diagnostics.AddError("Couldn't update load balancer credential", agg.Error())
+		return
+	}
+
+	plan.parse(*res.JSON200.Credential)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Credential
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.LoadBalancer.Credentials.Delete(ctx, state.ProjectID.ValueString(), state.CredentialsRef.ValueString())
+	if agg := validate.Response(res, err); agg != nil && !validate.StatusEquals(res, http.StatusNotFound) {
+		resp.Diagnostics.AddError("Couldn't delete load balancer credential", agg.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportString("credentials_ref", "id", "credentials_ref"))
+}
@@ -0,0 +1,37 @@
+package credential
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/credentials"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func resToStr(f *string) basetypes.StringValue {
+	if f == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(*f)
+}
+
+func (c *Credential) parse(res credentials.CredentialsResponse) {
+	c.ID = resToStr(res.CredentialsRef)
+	c.CredentialsRef = resToStr(res.CredentialsRef)
+	c.DisplayName = resToStr(res.DisplayName)
+	c.Username = resToStr(res.Username)
+}
+
+// password returns the configured password
+// write-only values are only part of the configuration, not of the plan
+func password(ctx context.Context, config tfsdk.Config, plan Credential, diags *diag.Diagnostics) *string {
+	if !plan.Password.IsNull() {
+		return plan.Password.ValueStringPointer()
+	}
+	var wo types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("password_wo"), &wo)...)
+	return wo.ValueStringPointer()
+}
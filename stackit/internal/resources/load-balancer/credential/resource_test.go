@@ -0,0 +1,135 @@
+package credential_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_LoadBalancerCredential(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "argus" + acctest.RandStringFromCharSet(7, acctest.CharSetAlpha)
+	projectID := common.GetAcceptanceTestsProjectID()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(projectID, name, "example"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_load_balancer_credential.example", "project_id", projectID),
+					resource.TestCheckResourceAttr("stackit_load_balancer_credential.example", "display_name", "example"),
+					resource.TestCheckResourceAttrSet("stackit_load_balancer_credential.example", "credentials_ref"),
+					resource.TestCheckResourceAttrPair("stackit_load_balancer_credential.example", "username", "stackit_argus_credential.example", "username"),
+				),
+			},
+			// update display name
+			{
+				Config: config(projectID, name, "example-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_load_balancer_credential.example", "display_name", "example-updated"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_load_balancer_credential.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_load_balancer_credential.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_load_balancer_credential.example")
+					}
+					ref, ok := r.Primary.Attributes["credentials_ref"]
+					if !ok {
+						return "", errors.New("couldn't find attribute credentials_ref")
+					}
+					return fmt.Sprintf("%s,%s", projectID, ref), nil
+				},
+				ImportStateVerifyIgnore: []string{"password"},
+				ImportState:             true,
+				ImportStateVerify:       true,
+			},
+			// switch to the write-only password
+			{
+				Config: configWriteOnly(projectID, name, "example-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("stackit_load_balancer_credential.example", "password"),
+					resource.TestCheckNoResourceAttr("stackit_load_balancer_credential.example", "password_wo"),
+					resource.TestCheckResourceAttr("stackit_load_balancer_credential.example", "password_wo_version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func config(projectID, name, displayName string) string {
+	return fmt.Sprintf(`
+	resource "stackit_argus_instance" "example" {
+		project_id = "%s"
+		name       = "%s"
+		plan       = "Monitoring-Medium-EU01"
+	}
+
+	resource "stackit_argus_credential" "example" {
+		project_id  = "%s"
+		instance_id = stackit_argus_instance.example.id
+	}
+
+	resource "stackit_load_balancer_credential" "example" {
+		project_id   = "%s"
+		display_name = "%s"
+		username     = stackit_argus_credential.example.username
+		password     = stackit_argus_credential.example.password
+	}
+	`,
+		projectID,
+		name,
+		projectID,
+		projectID,
+		displayName,
+	)
+}
+
+func configWriteOnly(projectID, name, displayName string) string {
+	return fmt.Sprintf(`
+	resource "stackit_argus_instance" "example" {
+		project_id = "%s"
+		name       = "%s"
+		plan       = "Monitoring-Medium-EU01"
+	}
+
+	resource "stackit_argus_credential" "example" {
+		project_id  = "%s"
+		instance_id = stackit_argus_instance.example.id
+	}
+
+	resource "stackit_load_balancer_credential" "example" {
+		project_id          = "%s"
+		display_name        = "%s"
+		username            = stackit_argus_credential.example.username
+		password_wo         = stackit_argus_credential.example.password
+		password_wo_version = 1
+	}
+	`,
+		projectID,
+		name,
+		projectID,
+		projectID,
+		displayName,
+	)
+}
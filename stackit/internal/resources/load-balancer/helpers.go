@@ -2,6 +2,8 @@ package loadbalancer
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
@@ -90,16 +92,38 @@ func prepareListeners(lb Instance) *[]instances.Listener {
 	var ls []Listener
 	_ = lb.Listeners.ElementsAs(context.Background(), &ls, false)
 	for _, l := range ls {
-		listeners = append(listeners, instances.Listener{
-			DisplayName: strPtrOrNil(l.DisplayName),
-			Port:        intPtrOrNil(l.Port),
-			Protocol:    (*instances.ListenerProtocol)(strPtrOrNil(l.Protocol)),
-			TargetPool:  strPtrOrNil(l.TargetPool),
-		})
+		listener := instances.Listener{
+			DisplayName:          strPtrOrNil(l.DisplayName),
+			Port:                 intPtrOrNil(l.Port),
+			Protocol:             (*instances.ListenerProtocol)(strPtrOrNil(l.Protocol)),
+			TargetPool:           strPtrOrNil(l.TargetPool),
+			ServerNameIndicators: prepareServerNameIndicators(l),
+		}
+		if timeout := strPtrOrNil(l.IdleTimeout); timeout != nil {
+			if l.Protocol.ValueString() == protocolUDP {
+				listener.UDP = &instances.OptionsUDP{IdleTimeout: timeout}
+			} else {
+				listener.TCP = &instances.OptionsTCP{IdleTimeout: timeout}
+			}
+		}
+		listeners = append(listeners, listener)
 	}
 	return &listeners
 }
 
+func prepareServerNameIndicators(l Listener) *[]instances.ServerNameIndicator {
+	if l.ServerNameIndicators.IsNull() || l.ServerNameIndicators.IsUnknown() {
+		return nil
+	}
+	var names []string
+	_ = l.ServerNameIndicators.ElementsAs(context.Background(), &names, false)
+	sni := []instances.ServerNameIndicator{}
+	for _, n := range names {
+		sni = append(sni, instances.ServerNameIndicator{Name: valptr(n)})
+	}
+	return &sni
+}
+
 func prepareNetworks(lb Instance) *[]instances.Network {
 	var networks []instances.Network
 	if lb.Networks.IsNull() || lb.Networks.IsUnknown() {
@@ -124,18 +148,35 @@ func prepareTargetPools(lb Instance) *[]instances.TargetPool {
 	var tp []TargetPool
 	_ = lb.TargetPools.ElementsAs(context.Background(), &tp, false)
 	for _, tp := range tp {
-		targetPools = append(targetPools, instances.TargetPool{
-			Name:              strPtrOrNil(tp.Name),
-			TargetPort:        intPtrOrNil(tp.TargetPort),
-			Targets:           prepareTargets(tp),
-			ActiveHealthCheck: prepareHealthCheck(tp),
-		})
+		targetPools = append(targetPools, PrepareTargetPool(tp))
 	}
 	return &targetPools
 }
 
+// PrepareTargetPool converts a target pool schema model to the API representation
+func PrepareTargetPool(tp TargetPool) instances.TargetPool {
+	return instances.TargetPool{
+		Name:               strPtrOrNil(tp.Name),
+		TargetPort:         intPtrOrNil(tp.TargetPort),
+		Targets:            prepareTargets(tp),
+		ActiveHealthCheck:  prepareHealthCheck(tp),
+		SessionPersistence: prepareSessionPersistence(tp),
+	}
+}
+
+func prepareSessionPersistence(tp TargetPool) *instances.SessionPersistence {
+	var sp SessionPersistence
+	if tp.SessionPersistence.IsNull() || tp.SessionPersistence.IsUnknown() {
+		return nil
+	}
+	_ = tp.SessionPersistence.As(context.Background(), &sp, basetypes.ObjectAsOptions{})
+	return &instances.SessionPersistence{
+		UseSourceIpAddress: boolPtrOrNil(sp.UseSourceIPAddress),
+	}
+}
+
 func prepareTargets(tp TargetPool) *[]instances.Target {
-	var targets []instances.Target
+	targets := []instances.Target{}
 	if tp.Targets.IsNull() || tp.Targets.IsUnknown() {
 		return nil
 	}
@@ -173,6 +214,7 @@ func prepareOptions(lb Instance) *instances.LoadBalancerOptions {
 	opts := instances.LoadBalancerOptions{
 		PrivateNetworkOnly: boolPtrOrNil(lb.PrivateNetworkOnly),
 		AccessControl:      prepareACL(lb),
+		Observability:      prepareObservability(lb),
 	}
 	if deep.Equal(opts, instances.LoadBalancerOptions{}) == nil {
 		return nil
@@ -192,6 +234,37 @@ func prepareACL(lb Instance) *instances.LoadbalancerOptionAccessControl {
 	return &acl
 }
 
+func prepareObservability(lb Instance) *instances.LoadbalancerOptionObservability {
+	var o Observability
+	if lb.Observability.IsNull() || lb.Observability.IsUnknown() {
+		return nil
+	}
+	_ = lb.Observability.As(context.Background(), &o, basetypes.ObjectAsOptions{})
+	obs := instances.LoadbalancerOptionObservability{}
+	if t, ok := prepareObservabilityTarget(o.Logs); ok {
+		obs.Logs = &instances.LoadbalancerOptionLogs{
+			PushUrl:        strPtrOrNil(t.PushURL),
+			CredentialsRef: strPtrOrNil(t.CredentialsRef),
+		}
+	}
+	if t, ok := prepareObservabilityTarget(o.Metrics); ok {
+		obs.Metrics = &instances.LoadbalancerOptionMetrics{
+			PushUrl:        strPtrOrNil(t.PushURL),
+			CredentialsRef: strPtrOrNil(t.CredentialsRef),
+		}
+	}
+	return &obs
+}
+
+func prepareObservabilityTarget(v types.Object) (ObservabilityTarget, bool) {
+	var t ObservabilityTarget
+	if v.IsNull() || v.IsUnknown() {
+		return t, false
+	}
+	_ = v.As(context.Background(), &t, basetypes.ObjectAsOptions{})
+	return t, true
+}
+
 func (i *Instance) parse(ctx context.Context, lb instances.LoadBalancer, diags *diag.Diagnostics) {
 	i.ID = resToStr(lb.Name)
 	i.Name = resToStr(lb.Name)
@@ -210,6 +283,9 @@ func (i *Instance) parseOptions(ctx context.Context, lb instances.LoadBalancer,
 	// Private Network only
 	i.PrivateNetworkOnly = resToBool(lb.Options.PrivateNetworkOnly)
 
+	// Observability
+	i.Observability = parseObservability(lb.Options.Observability)
+
 	// ACL
 	if lb.Options.AccessControl == nil ||
 		lb.Options.AccessControl.AllowedSourceRanges == nil {
@@ -262,17 +338,56 @@ func (i *Instance) parseListeners(ctx context.Context, lb instances.LoadBalancer
 	if lb.Listeners == nil {
 		return
 	}
+
+	// prior listeners are used to keep the configured representation of equivalent values
+	prior := map[string]Listener{}
+	if !i.Listeners.IsNull() && !i.Listeners.IsUnknown() {
+		var ls []Listener
+		_ = i.Listeners.ElementsAs(ctx, &ls, false)
+		for _, l := range ls {
+			prior[listenerKey(l.Protocol.ValueString(), l.Port.ValueInt64())] = l
+		}
+	}
+
 	listeners := []attr.Value{}
 	for _, l := range *lb.Listeners {
 		attrs := map[string]attr.Value{
-			"display_name": resToStr(l.DisplayName),
-			"port":         resToInt64(l.Port),
-			"protocol":     types.StringNull(),
-			"target_pool":  resToStr(l.TargetPool),
+			"display_name":           resToStr(l.DisplayName),
+			"port":                   resToInt64(l.Port),
+			"protocol":               types.StringNull(),
+			"target_pool":            resToStr(l.TargetPool),
+			"server_name_indicators": types.SetNull(types.StringType),
+			"idle_timeout":           types.StringNull(),
 		}
+		protocol := ""
 		if l.Protocol != nil {
-			attrs["protocol"] = types.StringValue(string(*l.Protocol))
+			protocol = string(*l.Protocol)
+			attrs["protocol"] = types.StringValue(protocol)
+		}
+		if l.ServerNameIndicators != nil && len(*l.ServerNameIndicators) > 0 {
+			names := []attr.Value{}
+			for _, n := range *l.ServerNameIndicators {
+				if n.Name != nil {
+					names = append(names, types.StringValue(*n.Name))
+				}
+			}
+			attrs["server_name_indicators"] = types.SetValueMust(types.StringType, names)
+		}
+
+		var timeout *string
+		if l.TCP != nil {
+			timeout = l.TCP.IdleTimeout
+		}
+		if l.UDP != nil {
+			timeout = l.UDP.IdleTimeout
+		}
+		port := int64(0)
+		if l.Port != nil {
+			port = int64(*l.Port)
 		}
+		p, known := prior[listenerKey(protocol, port)]
+		attrs["idle_timeout"] = parseIdleTimeout(p.IdleTimeout, timeout, known)
+
 		listeners = append(listeners, types.ObjectValueMust(listenerType, attrs))
 	}
 	val, d := types.SetValueFrom(
@@ -287,38 +402,58 @@ func (i *Instance) parseListeners(ctx context.Context, lb instances.LoadBalancer
 	i.Listeners = val
 }
 
+func listenerKey(protocol string, port int64) string {
+	return fmt.Sprintf("%s/%d", protocol, port)
+}
+
+// parseIdleTimeout keeps the prior value if it's equivalent to the returned one (e.g. `5m` and `300s`)
+// and ignores server side defaults for listeners that didn't set a timeout
+func parseIdleTimeout(prior basetypes.StringValue, res *string, hasPrior bool) basetypes.StringValue {
+	if res == nil {
+		return types.StringNull()
+	}
+	if !hasPrior || prior.IsUnknown() {
+		return types.StringValue(*res)
+	}
+	if prior.IsNull() {
+		return types.StringNull()
+	}
+	pd, perr := time.ParseDuration(prior.ValueString())
+	rd, rerr := time.ParseDuration(*res)
+	if perr == nil && rerr == nil && pd == rd {
+		return prior
+	}
+	return types.StringValue(*res)
+}
+
 func (i *Instance) parseTargetPools(ctx context.Context, lb instances.LoadBalancer, diags *diag.Diagnostics) {
 	if lb.TargetPools == nil {
 		return
 	}
+
+	prior := map[string]TargetPool{}
+	if !i.TargetPools.IsNull() && !i.TargetPools.IsUnknown() {
+		var tps []TargetPool
+		_ = i.TargetPools.ElementsAs(ctx, &tps, false)
+		for _, tp := range tps {
+			prior[tp.Name.ValueString()] = tp
+		}
+	}
+
 	targetPools := []attr.Value{}
 	for _, tp := range *lb.TargetPools {
-		attrs := map[string]attr.Value{
-			"name":         resToStr(tp.Name),
-			"target_port":  resToInt64(tp.TargetPort),
-			"targets":      types.SetNull(targetsType),
-			"health_check": types.ObjectNull(healthCheckType),
-		}
-		if tp.Targets != nil {
-			targets := []attr.Value{}
-			for _, t := range *tp.Targets {
-				targets = append(targets, types.ObjectValueMust(targetType, map[string]attr.Value{
-					"display_name": resToStr(t.DisplayName),
-					"ip_address":   resToStr(t.Ip),
-				}))
+		var p *TargetPool
+		if tp.Name != nil {
+			if v, ok := prior[*tp.Name]; ok {
+				p = &v
 			}
-			attrs["targets"] = types.SetValueMust(types.ObjectType{AttrTypes: targetType}, targets)
 		}
-		if tp.ActiveHealthCheck != nil {
-			attrs["health_check"] = types.ObjectValueMust(healthCheckType, map[string]attr.Value{
-				"healthy_threshold":   resToInt64(tp.ActiveHealthCheck.HealthyThreshold),
-				"interval":            resToStr(tp.ActiveHealthCheck.Interval),
-				"interval_jitter":     resToStr(tp.ActiveHealthCheck.IntervalJitter),
-				"timeout":             resToStr(tp.ActiveHealthCheck.Timeout),
-				"unhealthy_threshold": resToInt64(tp.ActiveHealthCheck.UnhealthyThreshold),
-			})
+		v, d := types.ObjectValueFrom(ctx, targetPoolType, ParseTargetPool(tp, p))
+		diags.Append(d...)
+		if diags.HasError() {
+			return
 		}
-		targetPools = append(targetPools, types.ObjectValueMust(targetPoolType, attrs))
+		targetPools = append(targetPools, v)
 	}
 	v, d := types.SetValueFrom(
 		ctx,
@@ -331,3 +466,66 @@ func (i *Instance) parseTargetPools(ctx context.Context, lb instances.LoadBalanc
 	}
 	i.TargetPools = v
 }
+
+// ParseTargetPool converts a target pool API response to the schema model
+// prior is the configured pool, if known, and is used to avoid diffs on equivalent values
+func ParseTargetPool(tp instances.TargetPool, prior *TargetPool) TargetPool {
+	res := TargetPool{
+		Name:               resToStr(tp.Name),
+		TargetPort:         resToInt64(tp.TargetPort),
+		Targets:            types.SetNull(types.ObjectType{AttrTypes: targetType}),
+		HealthCheck:        types.ObjectNull(healthCheckType),
+		SessionPersistence: types.ObjectNull(sessionPersistenceType),
+	}
+	if tp.Targets != nil {
+		targets := []attr.Value{}
+		for _, t := range *tp.Targets {
+			targets = append(targets, types.ObjectValueMust(targetType, map[string]attr.Value{
+				"display_name": resToStr(t.DisplayName),
+				"ip_address":   resToStr(t.Ip),
+			}))
+		}
+		res.Targets = types.SetValueMust(types.ObjectType{AttrTypes: targetType}, targets)
+	}
+	if tp.ActiveHealthCheck != nil {
+		res.HealthCheck = types.ObjectValueMust(healthCheckType, map[string]attr.Value{
+			"healthy_threshold":   resToInt64(tp.ActiveHealthCheck.HealthyThreshold),
+			"interval":            resToStr(tp.ActiveHealthCheck.Interval),
+			"interval_jitter":     resToStr(tp.ActiveHealthCheck.IntervalJitter),
+			"timeout":             resToStr(tp.ActiveHealthCheck.Timeout),
+			"unhealthy_threshold": resToInt64(tp.ActiveHealthCheck.UnhealthyThreshold),
+		})
+	}
+	// a disabled session persistence is equivalent to not setting it
+	if sp := tp.SessionPersistence; sp != nil && sp.UseSourceIpAddress != nil &&
+		(*sp.UseSourceIpAddress || (prior != nil && !prior.SessionPersistence.IsNull())) {
+		res.SessionPersistence = types.ObjectValueMust(sessionPersistenceType, map[string]attr.Value{
+			"use_source_ip_address": types.BoolValue(*sp.UseSourceIpAddress),
+		})
+	}
+	return res
+}
+
+func parseObservability(o *instances.LoadbalancerOptionObservability) basetypes.ObjectValue {
+	if o == nil || (o.Logs == nil && o.Metrics == nil) {
+		return types.ObjectNull(observabilityType)
+	}
+	logs := types.ObjectNull(observabilityTargetType)
+	if o.Logs != nil {
+		logs = types.ObjectValueMust(observabilityTargetType, map[string]attr.Value{
+			"push_url":        resToStr(o.Logs.PushUrl),
+			"credentials_ref": resToStr(o.Logs.CredentialsRef),
+		})
+	}
+	metrics := types.ObjectNull(observabilityTargetType)
+	if o.Metrics != nil {
+		metrics = types.ObjectValueMust(observabilityTargetType, map[string]attr.Value{
+			"push_url":        resToStr(o.Metrics.PushUrl),
+			"credentials_ref": resToStr(o.Metrics.CredentialsRef),
+		})
+	}
+	return types.ObjectValueMust(observabilityType, map[string]attr.Value{
+		"logs":    logs,
+		"metrics": metrics,
+	})
+}
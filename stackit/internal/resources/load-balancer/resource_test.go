@@ -79,12 +79,21 @@ func config(name, projectID string, os openstack) string {
 				display_name = "example-target"
 				ip_address   = openstack_compute_instance_v2.example.network.0.fixed_ip_v4
 			}]
+			session_persistence = {
+				use_source_ip_address = true
+			}
 		}]
 		listeners = [{
 			display_name = "example-listener"
 			port         = 80
 			protocol     = "PROTOCOL_TCP"
 			target_pool  = "example-target-pool"
+			idle_timeout = "5m"
+		}, {
+			display_name = "example-udp-listener"
+			port         = 53
+			protocol     = "PROTOCOL_UDP"
+			target_pool  = "example-target-pool"
 		}]
 		networks = [
 			{ network_id = openstack_networking_network_v2.example.id }
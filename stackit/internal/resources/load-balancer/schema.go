@@ -7,6 +7,7 @@ import (
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -34,21 +36,26 @@ type Instance struct {
 	ACL                types.Set      `tfsdk:"acl"`
 	PrivateNetworkOnly types.Bool     `tfsdk:"private_network_only"`
 	PrivateAddress     types.String   `tfsdk:"private_address"`
+	Observability      types.Object   `tfsdk:"observability"`
 	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 type Listener struct {
-	DisplayName types.String `tfsdk:"display_name"`
-	Port        types.Int64  `tfsdk:"port"`
-	Protocol    types.String `tfsdk:"protocol"`
-	TargetPool  types.String `tfsdk:"target_pool"`
+	DisplayName          types.String `tfsdk:"display_name"`
+	Port                 types.Int64  `tfsdk:"port"`
+	Protocol             types.String `tfsdk:"protocol"`
+	TargetPool           types.String `tfsdk:"target_pool"`
+	ServerNameIndicators types.Set    `tfsdk:"server_name_indicators"`
+	IdleTimeout          types.String `tfsdk:"idle_timeout"`
 }
 
 var listenerType = map[string]attr.Type{
-	"display_name": types.StringType,
-	"port":         types.Int64Type,
-	"protocol":     types.StringType,
-	"target_pool":  types.StringType,
+	"display_name":           types.StringType,
+	"port":                   types.Int64Type,
+	"protocol":               types.StringType,
+	"target_pool":            types.StringType,
+	"server_name_indicators": types.SetType{ElemType: types.StringType},
+	"idle_timeout":           types.StringType,
 }
 
 type Network struct {
@@ -62,10 +69,11 @@ var networkType = map[string]attr.Type{
 }
 
 type TargetPool struct {
-	Name        types.String `tfsdk:"name"`
-	TargetPort  types.Int64  `tfsdk:"target_port"`
-	Targets     types.Set    `tfsdk:"targets"`
-	HealthCheck types.Object `tfsdk:"health_check"`
+	Name               types.String `tfsdk:"name"`
+	TargetPort         types.Int64  `tfsdk:"target_port"`
+	Targets            types.Set    `tfsdk:"targets"`
+	HealthCheck        types.Object `tfsdk:"health_check"`
+	SessionPersistence types.Object `tfsdk:"session_persistence"`
 }
 
 var targetPoolType = map[string]attr.Type{
@@ -75,6 +83,9 @@ var targetPoolType = map[string]attr.Type{
 	"health_check": types.ObjectType{
 		AttrTypes: healthCheckType,
 	},
+	"session_persistence": types.ObjectType{
+		AttrTypes: sessionPersistenceType,
+	},
 }
 
 type Target struct {
@@ -109,6 +120,34 @@ var healthCheckType = map[string]attr.Type{
 	"unhealthy_threshold": types.Int64Type,
 }
 
+type SessionPersistence struct {
+	UseSourceIPAddress types.Bool `tfsdk:"use_source_ip_address"`
+}
+
+var sessionPersistenceType = map[string]attr.Type{
+	"use_source_ip_address": types.BoolType,
+}
+
+type Observability struct {
+	Logs    types.Object `tfsdk:"logs"`
+	Metrics types.Object `tfsdk:"metrics"`
+}
+
+var observabilityType = map[string]attr.Type{
+	"logs":    types.ObjectType{AttrTypes: observabilityTargetType},
+	"metrics": types.ObjectType{AttrTypes: observabilityTargetType},
+}
+
+type ObservabilityTarget struct {
+	PushURL        types.String `tfsdk:"push_url"`
+	CredentialsRef types.String `tfsdk:"credentials_ref"`
+}
+
+var observabilityTargetType = map[string]attr.Type{
+	"push_url":        types.StringType,
+	"credentials_ref": types.StringType,
+}
+
 // Schema returns the terraform schema structure
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -267,18 +306,33 @@ resource "openstack_networking_router_interface_v2" "example_interface" {
 						"port": schema.Int64Attribute{
 							Description: "The port the load balancer listens on [ 1 .. 65535 ].",
 							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
 						},
 						"protocol": schema.StringAttribute{
-							Description: "The protocol the load balancer listens on. Options: `PROTOCOL_TCP`, `PROTOCOL_UDP`, `PROTOCOL_TCP_PROXY`",
+							Description: "The protocol the load balancer listens on. Options: `PROTOCOL_TCP`, `PROTOCOL_UDP`, `PROTOCOL_TCP_PROXY`, `PROTOCOL_TLS_PASSTHROUGH`. The load balancer doesn't terminate TLS, `PROTOCOL_TLS_PASSTHROUGH` forwards encrypted connections and the targets have to present the certificates.",
 							Required:    true,
 							Validators: []validator.String{
-								stringvalidator.OneOf("PROTOCOL_TCP", "PROTOCOL_UDP", "PROTOCOL_TCP_PROXY"),
+								stringvalidator.OneOf(protocolTCP, protocolUDP, protocolTCPProxy, protocolTLSPassthrough),
 							},
 						},
 						"target_pool": schema.StringAttribute{
 							Description: "The target pool name.",
 							Optional:    true,
 						},
+						"server_name_indicators": schema.SetAttribute{
+							Description: "The server names (SNI) the listener accepts, read from the TLS handshake without decrypting the connection. Only supported with `PROTOCOL_TLS_PASSTHROUGH`.",
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"idle_timeout": schema.StringAttribute{
+							Description: "The time after which an idle connection is closed, e.g. `300s`. Applies to TCP connections or UDP flows depending on the `protocol`.",
+							Optional:    true,
+						},
 					},
 				},
 			},
@@ -310,66 +364,13 @@ resource "openstack_networking_router_interface_v2" "example_interface" {
 				},
 			},
 			"target_pools": schema.SetNestedAttribute{
-				Description: "The load balancers target pools.",
+				Description: "The load balancers target pools. Changes to existing pools are applied in place, adding or removing pools requires the resource to be recreated. Pools managed by `stackit_load_balancer_target_pool` have to be ignored with `lifecycle { ignore_changes = [target_pools] }`.",
 				Required:    true,
 				PlanModifiers: []planmodifier.Set{
-					setplanmodifier.RequiresReplace(),
+					requiresReplaceIfPoolsChanged(),
 				},
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Description: "The target pool name.",
-							Required:    true,
-						},
-						"target_port": schema.Int64Attribute{
-							Description: "The target port.",
-							Required:    true,
-						},
-						"targets": schema.SetNestedAttribute{
-							Description: "The target pool targets.",
-							Required:    true,
-							Validators: []validator.Set{
-								setvalidator.SizeAtLeast(1),
-							},
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"display_name": schema.StringAttribute{
-										Description: "The target display name.",
-										Required:    true,
-									},
-									"ip_address": schema.StringAttribute{
-										Description: "The target IP address.",
-										Required:    true,
-									},
-								},
-							},
-						},
-						"health_check": schema.SingleNestedAttribute{
-							Optional: true,
-							Attributes: map[string]schema.Attribute{
-								"healthy_threshold": schema.Int64Attribute{
-									Description: "The healthy threshold.",
-									Required:    true,
-								},
-								"interval": schema.StringAttribute{
-									Description: "The interval.",
-									Required:    true,
-								},
-								"interval_jitter": schema.StringAttribute{
-									Description: "The interval jitter.",
-									Required:    true,
-								},
-								"timeout": schema.StringAttribute{
-									Description: "The timeout.",
-									Required:    true,
-								},
-								"unhealthy_threshold": schema.Int64Attribute{
-									Description: "The unhealthy threshold.",
-									Required:    true,
-								},
-							},
-						},
-					},
+					Attributes: TargetPoolAttributes(),
 				},
 			},
 			"acl": schema.SetAttribute{
@@ -397,10 +398,107 @@ resource "openstack_networking_router_interface_v2" "example_interface" {
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"observability": schema.SingleNestedAttribute{
+				Description: "Pushes the load balancer metrics and access logs to an Argus instance.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"logs":    observabilityTargetSchema("logs", "logs_push_url"),
+					"metrics": observabilityTargetSchema("metrics", "metrics_push_url"),
+				},
+			},
 			"timeouts": common.Timeouts(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 				Delete: true,
 			}),
 		},
 	}
 }
+
+func observabilityTargetSchema(kind, argusAttr string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("Pushes the load balancer %s to an Argus instance.", kind),
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"push_url": schema.StringAttribute{
+				Description: fmt.Sprintf("The URL the %s are pushed to, e.g. `stackit_argus_instance.<name>.%s`.", kind, argusAttr),
+				Required:    true,
+			},
+			"credentials_ref": schema.StringAttribute{
+				Description: "The reference of the `stackit_load_balancer_credential` used to authenticate.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// TargetPoolAttributes returns the schema attributes of a single target pool
+func TargetPoolAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "The target pool name.",
+			Required:    true,
+		},
+		"target_port": schema.Int64Attribute{
+			Description: "The target port.",
+			Required:    true,
+		},
+		"targets": schema.SetNestedAttribute{
+			Description: "The target pool targets.",
+			Required:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"display_name": schema.StringAttribute{
+						Description: "The target display name.",
+						Required:    true,
+					},
+					"ip_address": schema.StringAttribute{
+						Description: "The target IP address.",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"health_check": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"healthy_threshold": schema.Int64Attribute{
+					Description: "The healthy threshold.",
+					Required:    true,
+				},
+				"interval": schema.StringAttribute{
+					Description: "The interval.",
+					Required:    true,
+				},
+				"interval_jitter": schema.StringAttribute{
+					Description: "The interval jitter.",
+					Required:    true,
+				},
+				"timeout": schema.StringAttribute{
+					Description: "The timeout.",
+					Required:    true,
+				},
+				"unhealthy_threshold": schema.Int64Attribute{
+					Description: "The unhealthy threshold.",
+					Required:    true,
+				},
+			},
+		},
+		"session_persistence": schema.SingleNestedAttribute{
+			Description: "The session persistence (stickiness) of the target pool.",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"use_source_ip_address": schema.BoolAttribute{
+					Description: "Route connections from the same source IP address to the same target.",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
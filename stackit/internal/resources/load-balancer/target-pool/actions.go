@@ -0,0 +1,159 @@
+package targetpool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	loadbalancer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/load-balancer"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TargetPool
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, nil, timeout, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TargetPool
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tp, err := r.getTargetPool(ctx, state.ProjectID.ValueString(), state.LoadBalancerName.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Couldn't get load balancer information", err.Error())
+		return
+	}
+	if tp == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.parse(*tp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state TargetPool
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Update(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &plan, &state, timeout, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// apply updates the target pool on the load balancer and waits for the change to be applied
+// if prior is set, the update fails when the pool was changed since it was last read
+func (r Resource) apply(ctx context.Context, plan, prior *TargetPool, timeout time.Duration, diags *diag.Diagnostics) {
+	projectID := plan.ProjectID.ValueString()
+	lbName := plan.LoadBalancerName.ValueString()
+	name := plan.Name.ValueString()
+
+	unlock := loadbalancer.LockTargetPools(projectID, lbName)
+	defer unlock()
+
+	tp, err := r.getTargetPool(ctx, projectID, lbName, name)
+	if err != nil {
+		diags.AddError("Couldn't get load balancer information", err.Error())
+		return
+	}
+	if tp == nil {
+		diags.AddError("Target pool not found", fmt.Sprintf("target pool '%s' isn't declared in load balancer '%s'", name, lbName))
+		return
+	}
+	if prior != nil && prior.changed(*tp) {
+		diags.AddError("Target pool changed concurrently",
+			fmt.Sprintf("target pool '%s' of load balancer '%s' was changed since it was last read. Refresh the state and apply again.", name, lbName))
+		return
+	}
+
+	res, err := loadbalancer.UpdateTargetPool(ctx, r.client, projectID, lbName, plan.toLoadBalancerModel(), timeout)
+	if err != nil {
+		diags.AddError("Couldn't update target pool", err.Error())
+		return
+	}
+
+	plan.parse(*res)
+}
+
+// Delete - lifecycle function
+// target pools can't be deleted on their own, so their targets are removed and the pool stops serving traffic
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TargetPool
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := state.Timeouts.Delete(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	lbName := state.LoadBalancerName.ValueString()
+	unlock := loadbalancer.LockTargetPools(projectID, lbName)
+	defer unlock()
+
+	tp, err := r.getTargetPool(ctx, projectID, lbName, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Couldn't get load balancer information", err.Error())
+		return
+	}
+	if tp == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	empty := state.toLoadBalancerModel()
+	empty.Targets = types.SetValueMust(empty.Targets.ElementType(ctx), []attr.Value{})
+	if _, err := loadbalancer.UpdateTargetPool(ctx, r.client, projectID, lbName, empty, timeout); err != nil {
+		resp.Diagnostics.AddError("Couldn't remove the targets of the target pool",
+			fmt.Sprintf("%s\nRemove the pool from the load balancer's `target_pools` to delete it.", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, ok := common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportString("load_balancer_name"), common.ImportString("name")); !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,63 @@
+package targetpool
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	loadbalancer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/load-balancer"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (tp *TargetPool) toLoadBalancerModel() loadbalancer.TargetPool {
+	return loadbalancer.TargetPool{
+		Name:               tp.Name,
+		TargetPort:         tp.TargetPort,
+		Targets:            tp.Targets,
+		HealthCheck:        tp.HealthCheck,
+		SessionPersistence: tp.SessionPersistence,
+	}
+}
+
+func (tp *TargetPool) parse(res instances.TargetPool) {
+	prior := tp.toLoadBalancerModel()
+	p := loadbalancer.ParseTargetPool(res, &prior)
+	tp.ID = types.StringValue(fmt.Sprintf("%s,%s,%s", tp.ProjectID.ValueString(), tp.LoadBalancerName.ValueString(), p.Name.ValueString()))
+	tp.Name = p.Name
+	tp.TargetPort = p.TargetPort
+	tp.Targets = p.Targets
+	tp.HealthCheck = p.HealthCheck
+	tp.SessionPersistence = p.SessionPersistence
+}
+
+// changed reports if the remote pool differs from tp, i.e. it was changed since tp was read
+func (tp *TargetPool) changed(remote instances.TargetPool) bool {
+	current := *tp
+	current.parse(remote)
+	return !current.TargetPort.Equal(tp.TargetPort) ||
+		!current.Targets.Equal(tp.Targets) ||
+		!current.HealthCheck.Equal(tp.HealthCheck) ||
+		!current.SessionPersistence.Equal(tp.SessionPersistence)
+}
+
+// getTargetPool returns the target pool from the load balancer, or nil if the load balancer or pool don't exist
+func (r Resource) getTargetPool(ctx context.Context, projectID, lbName, name string) (*instances.TargetPool, error) {
+	res, err := r.client.LoadBalancer.Instances.Get(ctx, projectID, lbName)
+	if agg := validate.Response(res, err, "JSON200"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			return nil, nil
+		}
+		return nil, agg
+	}
+	if res.JSON200.TargetPools == nil {
+		return nil, nil
+	}
+	for _, tp := range *res.JSON200.TargetPools {
+		if tp.Name != nil && *tp.Name == name {
+			return &tp, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,99 @@
+package targetpool_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_LoadBalancerTargetPool(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	projectID := common.GetAcceptanceTestsProjectID()
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	networkID := os.Getenv("ACC_TEST_LB_NETWORK_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(projectID, name, networkID, "192.168.0.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_load_balancer_target_pool.example", "name", "example-target-pool"),
+					resource.TestCheckResourceAttr("stackit_load_balancer_target_pool.example", "targets.#", "1"),
+				),
+			},
+			// change targets
+			{
+				Config: config(projectID, name, networkID, "192.168.0.11"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("stackit_load_balancer_target_pool.example", "targets.*", map[string]string{
+						"ip_address": "192.168.0.11",
+					}),
+				),
+			},
+			// test import
+			{
+				ResourceName:            "stackit_load_balancer_target_pool.example",
+				ImportStateId:           fmt.Sprintf("%s,%s,%s", projectID, name, "example-target-pool"),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func config(projectID, name, networkID, ip string) string {
+	return fmt.Sprintf(`
+	resource "stackit_load_balancer" "example" {
+		project_id = "%s"
+		name       = "%s"
+		target_pools = [{
+			name        = "example-target-pool"
+			target_port = 80
+			targets = [{
+				display_name = "initial"
+				ip_address   = "192.168.0.10"
+			}]
+		}]
+		listeners = [{
+			display_name = "example-listener"
+			port         = 80
+			protocol     = "PROTOCOL_TCP"
+			target_pool  = "example-target-pool"
+		}]
+		networks = [
+			{ network_id = "%s" }
+		]
+		lifecycle {
+			ignore_changes = [target_pools]
+		}
+	}
+
+	resource "stackit_load_balancer_target_pool" "example" {
+		project_id         = "%s"
+		load_balancer_name = stackit_load_balancer.example.name
+		name               = "example-target-pool"
+		target_port        = 80
+		targets = [{
+			display_name = "example-target"
+			ip_address   = "%s"
+		}]
+	}
+	`, projectID, name, networkID, projectID, ip)
+}
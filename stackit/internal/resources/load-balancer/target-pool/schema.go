@@ -0,0 +1,84 @@
+package targetpool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	loadbalancer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/load-balancer"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TargetPool is the schema model
+type TargetPool struct {
+	ID                 types.String   `tfsdk:"id"`
+	ProjectID          types.String   `tfsdk:"project_id"`
+	LoadBalancerName   types.String   `tfsdk:"load_balancer_name"`
+	Name               types.String   `tfsdk:"name"`
+	TargetPort         types.Int64    `tfsdk:"target_port"`
+	Targets            types.Set      `tfsdk:"targets"`
+	HealthCheck        types.Object   `tfsdk:"health_check"`
+	SessionPersistence types.Object   `tfsdk:"session_persistence"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := loadbalancer.TargetPoolAttributes()
+	attrs["id"] = schema.StringAttribute{
+		Description: "Specifies the resource ID",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attrs["project_id"] = schema.StringAttribute{
+		Description: "The project UUID. Changing this value requires the resource to be recreated.",
+		Required:    true,
+		Validators: []validator.String{
+			validate.ProjectID(),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attrs["load_balancer_name"] = schema.StringAttribute{
+		Description: "The name of the load balancer the target pool belongs to. Changing this value requires the resource to be recreated.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attrs["name"] = schema.StringAttribute{
+		Description: "The target pool name. The pool must be declared in the load balancer's `target_pools`. Changing this value requires the resource to be recreated.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attrs["timeouts"] = common.Timeouts(ctx, timeouts.Opts{
+		Create: true,
+		Update: true,
+		Delete: true,
+	})
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf(`Manages the targets, health check and session persistence of a single target pool of an existing load balancer.
+
+This allows the targets to be managed independently of the load balancer definition. The pool needs to be declared in `+"`stackit_load_balancer`"+`, which should ignore changes to its `+"`target_pools`"+` using `+"`lifecycle { ignore_changes = [target_pools] }`"+`.
+
+-> __Limitation__<small>Target pools can't be deleted on their own. Destroying this resource removes all targets of the pool, which stays declared on the load balancer until it's removed from the load balancer's <code>target_pools</code>. Changes are only serialized within a single Terraform run, an update fails if the pool was changed since it was last read.</small>
+
+%s`,
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: attrs,
+	}
+}
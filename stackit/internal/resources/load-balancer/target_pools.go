@@ -0,0 +1,127 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/providerdata"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/wait"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// targetPoolGracePeriod is how long the load balancer may take to pick up a target pool change
+// until then it reports the stale ready status
+const targetPoolGracePeriod = time.Minute
+
+// locks serializes changes to the target pools of the same load balancer
+// entries are removed once no change holds or waits for them
+var locks = struct {
+	sync.Mutex
+	m map[string]*poolLock
+}{m: map[string]*poolLock{}}
+
+type poolLock struct {
+	sync.Mutex
+	refs int
+}
+
+// LockTargetPools locks the target pools of a load balancer and returns the unlock function
+// the lock only covers this provider process, i.e. a load balancer and its target pool resources applied together,
+// stackit_load_balancer_target_pool detects changes made elsewhere by comparing the pool with its prior state before updating it
+func LockTargetPools(projectID, name string) func() {
+	key := projectID + "/" + name
+	locks.Lock()
+	l, ok := locks.m[key]
+	if !ok {
+		l = &poolLock{}
+		locks.m[key] = l
+	}
+	l.refs++
+	locks.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		locks.Lock()
+		defer locks.Unlock()
+		l.refs--
+		if l.refs == 0 {
+			delete(locks.m, key)
+		}
+	}
+}
+
+// UpdateTargetPool updates a target pool in place and waits until the load balancer applied the change
+func UpdateTargetPool(ctx context.Context, c *providerdata.Client, projectID, name string, tp TargetPool, timeout time.Duration) (*instances.TargetPool, error) {
+	res, err := c.LoadBalancer.TargetPools.Update(ctx, projectID, name, tp.Name.ValueString(), PrepareTargetPool(tp))
+	if agg := validate.Response(res, err, "JSON200"); agg != nil {
+		return nil, agg
+	}
+
+	status := func(ctx context.Context) (string, error) {
+		res, err := c.LoadBalancer.Instances.Get(ctx, projectID, name)
+		if agg := validate.Response(res, err, "JSON200.Status"); agg != nil {
+			return "", agg
+		}
+		return string(*res.JSON200.Status), nil
+	}
+	what := fmt.Sprintf("load balancer %s to apply target pool %s", name, tp.Name.ValueString())
+	if err := wait.Transition(ctx, what, timeout, targetPoolGracePeriod, status, string(instances.STATUS_READY), string(instances.STATUS_ERROR)); err != nil {
+		return nil, err
+	}
+	return res.JSON200, nil
+}
+
+// targetPoolNames returns the names of the target pools in a set
+// ok is false if the set or one of the names is unknown
+func targetPoolNames(s types.Set) (names map[string]bool, ok bool) {
+	names = map[string]bool{}
+	if s.IsUnknown() {
+		return nil, false
+	}
+	for _, v := range s.Elements() {
+		obj, isObj := v.(types.Object)
+		if !isObj || obj.IsUnknown() {
+			return nil, false
+		}
+		name, isStr := obj.Attributes()["name"].(types.String)
+		if !isStr || name.IsUnknown() {
+			return nil, false
+		}
+		names[name.ValueString()] = true
+	}
+	return names, true
+}
+
+// requiresReplaceIfPoolsChanged replaces the load balancer only if target pools are added or removed
+// changes to existing pools are applied in place, so pools managed by stackit_load_balancer_target_pool don't replace it
+func requiresReplaceIfPoolsChanged() planmodifier.Set {
+	return setplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+			prior, ok := targetPoolNames(req.StateValue)
+			if !ok {
+				resp.RequiresReplace = true
+				return
+			}
+			planned, ok := targetPoolNames(req.PlanValue)
+			if !ok || len(prior) != len(planned) {
+				resp.RequiresReplace = true
+				return
+			}
+			for name := range planned {
+				if !prior[name] {
+					resp.RequiresReplace = true
+					return
+				}
+			}
+		},
+		"Adding or removing target pools requires the load balancer to be recreated, changes to existing pools are applied in place.",
+		"Adding or removing target pools requires the load balancer to be recreated, changes to existing pools are applied in place.",
+	)
+}
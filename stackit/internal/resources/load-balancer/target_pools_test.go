@@ -0,0 +1,39 @@
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+func TestLockTargetPools(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	unlock := LockTargetPools("project", "lb")
+	waiting := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		close(waiting)
+		LockTargetPools("project", "lb")()
+		close(done)
+	}()
+	<-waiting
+
+	select {
+	case <-done:
+		t.Fatal("LockTargetPools() should block while the load balancer is locked")
+	default:
+	}
+	unlock()
+	<-done
+
+	locks.Lock()
+	defer locks.Unlock()
+	if len(locks.m) != 0 {
+		t.Errorf("locks should be removed once released, got %v", locks.m)
+	}
+}
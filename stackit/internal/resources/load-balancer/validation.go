@@ -0,0 +1,99 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	protocolTCP            = "PROTOCOL_TCP"
+	protocolUDP            = "PROTOCOL_UDP"
+	protocolTCPProxy       = "PROTOCOL_TCP_PROXY"
+	protocolTLSPassthrough = "PROTOCOL_TLS_PASSTHROUGH"
+)
+
+// ValidateConfig validates the listeners against each other and the configured target pools
+func (r Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config Instance
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Listeners.IsNull() || config.Listeners.IsUnknown() {
+		return
+	}
+	var listeners []Listener
+	resp.Diagnostics.Append(config.Listeners.ElementsAs(ctx, &listeners, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// pools is nil when the target pool names aren't known yet
+	var pools map[string]bool
+	if !config.TargetPools.IsNull() && !config.TargetPools.IsUnknown() {
+		var tps []TargetPool
+		resp.Diagnostics.Append(config.TargetPools.ElementsAs(ctx, &tps, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		pools = map[string]bool{}
+		for _, tp := range tps {
+			if tp.Name.IsUnknown() {
+				pools = nil
+				break
+			}
+			pools[tp.Name.ValueString()] = true
+		}
+	}
+
+	for _, err := range validateListeners(listeners, pools) {
+		resp.Diagnostics.AddAttributeError(path.Root("listeners"), "invalid listener", err.Error())
+	}
+}
+
+// validateListeners checks listener options and references
+// unknown values are skipped, as they're validated once known
+func validateListeners(listeners []Listener, pools map[string]bool) []error {
+	errs := []error{}
+	ports := map[string]string{}
+	for _, l := range listeners {
+		name := l.DisplayName.ValueString()
+		protocol := l.Protocol.ValueString()
+		known := !l.Protocol.IsUnknown() && !l.Protocol.IsNull()
+
+		if known && !l.Port.IsUnknown() && !l.Port.IsNull() {
+			// TCP based protocols share the port space, UDP has its own
+			key := fmt.Sprintf("tcp/%d", l.Port.ValueInt64())
+			if protocol == protocolUDP {
+				key = fmt.Sprintf("udp/%d", l.Port.ValueInt64())
+			}
+			if other, ok := ports[key]; ok {
+				errs = append(errs, fmt.Errorf("listeners '%s' and '%s' use the same port %s", other, name, key))
+			}
+			ports[key] = name
+		}
+
+		if known && protocol != protocolTLSPassthrough && !l.ServerNameIndicators.IsNull() {
+			errs = append(errs, fmt.Errorf("listener '%s': server_name_indicators are only supported with %s", name, protocolTLSPassthrough))
+		}
+
+		if !l.IdleTimeout.IsNull() && !l.IdleTimeout.IsUnknown() {
+			d, err := time.ParseDuration(l.IdleTimeout.ValueString())
+			if err != nil {
+				errs = append(errs, fmt.Errorf("listener '%s': idle_timeout '%s' isn't a valid duration (e.g. `300s`)", name, l.IdleTimeout.ValueString()))
+			} else if d <= 0 {
+				errs = append(errs, fmt.Errorf("listener '%s': idle_timeout must be positive", name))
+			}
+		}
+
+		if pools != nil && !l.TargetPool.IsNull() && !l.TargetPool.IsUnknown() && !pools[l.TargetPool.ValueString()] {
+			errs = append(errs, fmt.Errorf("listener '%s': target pool '%s' is not defined in target_pools", name, l.TargetPool.ValueString()))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,97 @@
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func listener(name, protocol string, port int64) Listener {
+	return Listener{
+		DisplayName:          types.StringValue(name),
+		Port:                 types.Int64Value(port),
+		Protocol:             types.StringValue(protocol),
+		TargetPool:           types.StringValue("pool"),
+		ServerNameIndicators: types.SetNull(types.StringType),
+		IdleTimeout:          types.StringNull(),
+	}
+}
+
+func TestValidateListeners(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	pools := map[string]bool{"pool": true}
+
+	sni := listener("passthrough", protocolTLSPassthrough, 443)
+	sni.ServerNameIndicators = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("example.com")})
+
+	badSNI := listener("tcp", protocolTCP, 443)
+	badSNI.ServerNameIndicators = sni.ServerNameIndicators
+
+	timeout := listener("udp", protocolUDP, 53)
+	timeout.IdleTimeout = types.StringValue("2m")
+
+	badTimeout := listener("tcp", protocolTCP, 80)
+	badTimeout.IdleTimeout = types.StringValue("2 minutes")
+
+	unknownPool := listener("tcp", protocolTCP, 80)
+	unknownPool.TargetPool = types.StringValue("other")
+
+	tests := []struct {
+		name      string
+		listeners []Listener
+		pools     map[string]bool
+		errs      int
+	}{
+		{"valid", []Listener{listener("a", protocolTCP, 80), sni, timeout}, pools, 0},
+		{"same port tcp and udp", []Listener{listener("a", protocolTCP, 53), listener("b", protocolUDP, 53)}, pools, 0},
+		{"duplicate port", []Listener{listener("a", protocolTCP, 80), listener("b", protocolTCPProxy, 80)}, pools, 1},
+		{"sni without passthrough", []Listener{badSNI}, pools, 1},
+		{"invalid timeout", []Listener{badTimeout}, pools, 1},
+		{"missing target pool", []Listener{unknownPool}, pools, 1},
+		{"unknown target pools", []Listener{unknownPool}, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := validateListeners(tt.listeners, tt.pools); len(errs) != tt.errs {
+				t.Errorf("validateListeners() got %d errors, want %d: %v", len(errs), tt.errs, errs)
+			}
+		})
+	}
+}
+
+func TestParseIdleTimeout(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	res := "300s"
+	tests := []struct {
+		name     string
+		prior    types.String
+		res      *string
+		hasPrior bool
+		want     types.String
+	}{
+		{"import", types.StringNull(), &res, false, types.StringValue("300s")},
+		{"equivalent", types.StringValue("5m"), &res, true, types.StringValue("5m")},
+		{"changed", types.StringValue("1m"), &res, true, types.StringValue("300s")},
+		{"server default", types.StringNull(), &res, true, types.StringNull()},
+		{"not returned", types.StringValue("5m"), nil, true, types.StringNull()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseIdleTimeout(tt.prior, tt.res, tt.hasPrior); !got.Equal(tt.want) {
+				t.Errorf("parseIdleTimeout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,13 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/instance"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -29,7 +28,7 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	plan.setDefaults()
 
 	// validate
-	if err := r.validate(ctx, &resp.Diagnostics, plan); err != nil {
+	if err := r.validate(ctx, plan); err != nil {
 		resp.Diagnostics.AddError("failed mongodb validation", err.Error())
 		return
 	}
@@ -104,21 +103,31 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
-	// The API currently has a bug that causes the instance to initially get a FAILED status
-	// To overcome the bug, we'll wait an initial 30 sec
-	time.Sleep(60 * time.Second)
-
 	timeout, d := plan.Timeouts.Create(ctx, 30*time.Minute)
 	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The API currently has a bug that causes the instance to initially get a FAILED status
+	// To overcome the bug, we wait until the instance is processed before validating its status
+	if err := waitForProcessing(ctx, r.client.MongoDBFlex.Instance, plan.ProjectID.ValueString(), instanceID, timeout); err != nil {
+		resp.Diagnostics.AddError("failed MongoDB instance creation validation", err.Error())
+		return
+	}
+
 	process := res.WaitHandler(ctx, r.client.MongoDBFlex.Instance, plan.ProjectID.ValueString(), instanceID).SetTimeout(timeout)
 	if _, err := process.WaitWithContext(ctx); err != nil {
 		resp.Diagnostics.AddError("failed MongoDB instance creation validation", err.Error())
 		return
 	}
 
+	if !plan.CloneFrom.IsNull() && !plan.CloneFrom.IsUnknown() {
+		r.clone(ctx, &resp.Diagnostics, plan, timeout)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// read cluster
 	get, err := r.client.MongoDBFlex.Instance.Get(ctx, plan.ProjectID.ValueString(), instanceID)
 	if agg := common.Validate(&resp.Diagnostics, get, err, "JSON200.Item"); agg != nil {
@@ -139,6 +148,34 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	}
 }
 
+// clone copies the data of the source instance into the newly created instance
+func (r Resource) clone(ctx context.Context, diags *diag.Diagnostics, plan Instance, timeout time.Duration) {
+	var from CloneFrom
+	diags.Append(plan.CloneFrom.As(ctx, &from, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return
+	}
+
+	targetID := plan.ID.ValueString()
+	body := instance.InstanceCloneInstanceRequest{
+		InstanceID: &targetID,
+	}
+	if !from.Timestamp.IsNull() && !from.Timestamp.IsUnknown() {
+		ts := from.Timestamp.ValueString()
+		body.Timestamp = &ts
+	}
+
+	res, err := r.client.MongoDBFlex.Instance.Clone(ctx, plan.ProjectID.ValueString(), from.InstanceID.ValueString(), body)
+	if agg := common.Validate(diags, res, err); agg != nil {
+		diags.AddError("failed cloning MongoDB flex instance", agg.Error())
+		return
+	}
+
+	if err := waitForClone(ctx, r.client.MongoDBFlex.Instance, plan.ProjectID.ValueString(), targetID, timeout); err != nil {
+		diags.AddError("failed MongoDB instance clone validation", err.Error())
+	}
+}
+
 // Read - lifecycle function
 func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state Instance
@@ -149,6 +186,8 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
+	common.ReadDeletionProtection(&state.DeletionProtection)
+
 	// read cluster
 	res, err := r.client.MongoDBFlex.Instance.Get(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
 	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200.Item"); agg != nil {
@@ -178,7 +217,7 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 	}
 
 	// validate
-	if err := r.validate(ctx, &resp.Diagnostics, plan); err != nil {
+	if err := r.validate(ctx, plan); err != nil {
 		resp.Diagnostics.AddError("failed mongodb validation", err.Error())
 		return
 	}
@@ -276,6 +315,10 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		return
 	}
 
+	if common.DeletionProtected(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+
 	res, err := r.client.MongoDBFlex.Instance.Delete(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
 	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
 		if validate.StatusEquals(res, http.StatusNotFound) {
@@ -300,32 +343,28 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState handles terraform import
-func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,mongodb_instance_id`.\nInstead got: %q", req.ID),
-		)
-		return
+// ImportParts returns the parts of the import identifier `project_id,mongodb_instance_id|name`
+func ImportParts() []common.ImportPart {
+	return []common.ImportPart{
+		common.ImportProjectID(),
+		common.ImportIDOrName("mongodb_instance_id"),
 	}
+}
 
-	// validate project id
-	if err := clientValidate.ProjectID(idParts[0]); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, ok := common.ImportState(ctx, req, resp, ImportParts()...)
+	if !ok {
 		return
 	}
 
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
-
-	if resp.Diagnostics.HasError() {
-		return
+	projectID, instanceID := id.Get("project_id"), id.Get("mongodb_instance_id")
+	if id.IsName("mongodb_instance_id") {
+		var err error
+		if instanceID, err = r.instanceIDByName(ctx, &resp.Diagnostics, projectID, instanceID); err != nil {
+			resp.Diagnostics.AddError("failed to find instance by name", err.Error())
+			return
+		}
 	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), instanceID)...)
 }
@@ -4,11 +4,18 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/flavors"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/instance"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/versions"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/wait"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/pkg/errors"
@@ -25,6 +32,11 @@ const (
 	DefaultReplicas       int64 = 1
 )
 
+var cloneFromType = map[string]attr.Type{
+	"instance_id": types.StringType,
+	"timestamp":   types.StringType,
+}
+
 func (i *Instance) setDefaults() {
 	if i.Version.IsNull() || i.Version.IsUnknown() {
 		i.Version = types.StringValue(DefaultVersion)
@@ -39,11 +51,11 @@ func (i *Instance) setDefaults() {
 	}
 }
 
-func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data Instance) error {
-	if err := r.validateVersion(ctx, diags, data.ProjectID.ValueString(), data.Version.ValueString()); err != nil {
+func (r Resource) validate(ctx context.Context, data Instance) error {
+	if err := r.validateVersion(ctx, data.ProjectID.ValueString(), data.Version.ValueString()); err != nil {
 		return err
 	}
-	if err := r.validateMachineType(ctx, diags, data.ProjectID.ValueString(), data.MachineType.ValueString(), data.Type.ValueString()); err != nil {
+	if err := r.validateMachineType(ctx, data.ProjectID.ValueString(), data.MachineType.ValueString(), data.Type.ValueString()); err != nil {
 		return err
 	}
 
@@ -57,20 +69,58 @@ func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data In
 		return errors.New("failed setting storage from object")
 	}
 
-	if err := r.validateStorage(ctx, diags, data.ProjectID.ValueString(), data.MachineType.ValueString(), storage); err != nil {
+	if err := r.validateStorage(ctx, data.ProjectID.ValueString(), data.MachineType.ValueString(), storage); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (r Resource) validateVersion(ctx context.Context, diags *diag.Diagnostics, projectID, version string) error {
-	res, err := r.client.MongoDBFlex.Versions.List(ctx, projectID)
-	if agg := common.Validate(diags, res, err, "JSON200.Versions"); agg != nil {
-		return errors.Wrap(agg, "failed validating version")
+// validatePlan validates the machine type and storage at plan time
+// values that aren't known yet are validated on apply
+func (r Resource) validatePlan(ctx context.Context, diags *diag.Diagnostics, plan Instance) {
+	if r.client == nil || plan.ProjectID.IsUnknown() || plan.MachineType.IsUnknown() || plan.Type.IsUnknown() {
+		return
+	}
+	if err := r.validateMachineType(ctx, plan.ProjectID.ValueString(), plan.MachineType.ValueString(), plan.Type.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("machine_type"), "invalid machine type", err.Error())
+		return
+	}
+
+	if plan.Storage.IsNull() || plan.Storage.IsUnknown() {
+		return
+	}
+	storage := Storage{}
+	if d := plan.Storage.As(ctx, &storage, basetypes.ObjectAsOptions{}); d.HasError() {
+		diags.Append(d...)
+		return
+	}
+	if storage.Class.IsUnknown() || storage.Size.IsUnknown() {
+		return
+	}
+	if err := r.validateStorage(ctx, plan.ProjectID.ValueString(), plan.MachineType.ValueString(), storage); err != nil {
+		diags.AddAttributeError(path.Root("storage"), "invalid storage", err.Error())
+	}
+}
+
+func (r Resource) listVersions(ctx context.Context, projectID string) ([]string, error) {
+	res, err := cache.Load(ctx, r.client.Cache, cache.Key("mongodb-flex/versions", projectID), func(ctx context.Context) (*versions.ListResponse, error) {
+		res, err := r.client.MongoDBFlex.Versions.List(ctx, projectID)
+		return res, validate.Response(res, err, "JSON200.Versions")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return *res.JSON200.Versions, nil
+}
+
+func (r Resource) validateVersion(ctx context.Context, projectID, version string) error {
+	list, err := r.listVersions(ctx, projectID)
+	if err != nil {
+		return errors.Wrap(err, "failed validating version")
 	}
 
 	opts := ""
-	for _, v := range *res.JSON200.Versions {
+	for _, v := range list {
 		opts = opts + "\n- " + v
 		if strings.EqualFold(v, version) {
 			return nil
@@ -79,10 +129,13 @@ func (r Resource) validateVersion(ctx context.Context, diags *diag.Diagnostics,
 	return fmt.Errorf("couldn't find version '%s'. Available options are:%s\n", version, opts)
 }
 
-func (r Resource) validateMachineType(ctx context.Context, diags *diag.Diagnostics, projectID, flavorID, serviceType string) error {
-	res, err := r.client.MongoDBFlex.Flavors.List(ctx, projectID)
-	if agg := common.Validate(diags, res, err, "JSON200.Flavors"); agg != nil {
-		return errors.Wrap(agg, "failed validating machine type (flavors)")
+func (r Resource) validateMachineType(ctx context.Context, projectID, flavorID, serviceType string) error {
+	res, err := cache.Load(ctx, r.client.Cache, cache.Key("mongodb-flex/flavors", projectID), func(ctx context.Context) (*flavors.ListResponse, error) {
+		res, err := r.client.MongoDBFlex.Flavors.List(ctx, projectID)
+		return res, validate.Response(res, err, "JSON200.Flavors")
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed validating machine type (flavors)")
 	}
 
 	opts := ""
@@ -117,14 +170,17 @@ func (r Resource) validateMachineType(ctx context.Context, diags *diag.Diagnosti
 	return fmt.Errorf("couldn't find machine type '%s'. Available options are:%s\n", flavorID, opts)
 }
 
-func (r Resource) validateStorage(ctx context.Context, diags *diag.Diagnostics, projectID, machineType string, storage Storage) error {
-	res, err := r.client.MongoDBFlex.Flavors.GetStorageOptions(ctx, projectID, machineType)
-	if agg := common.Validate(diags, res, err, "JSON200.StorageRange"); agg != nil {
-		return errors.Wrap(agg, "failed validating storage range")
+func (r Resource) validateStorage(ctx context.Context, projectID, machineType string, storage Storage) error {
+	res, err := cache.Load(ctx, r.client.Cache, cache.Key("mongodb-flex/storages", projectID, machineType), func(ctx context.Context) (*flavors.GetStorageOptionsResponse, error) {
+		res, err := r.client.MongoDBFlex.Flavors.GetStorageOptions(ctx, projectID, machineType)
+		return res, validate.Response(res, err, "JSON200.StorageClasses")
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed validating storage range")
 	}
 
 	size := storage.Size.ValueInt64()
-	if res.JSON200.StorageRange.Max != nil && res.JSON200.StorageRange.Min != nil {
+	if res.JSON200.StorageRange != nil && res.JSON200.StorageRange.Max != nil && res.JSON200.StorageRange.Min != nil {
 		if int64(*res.JSON200.StorageRange.Max) < size || int64(*res.JSON200.StorageRange.Min) > size {
 			return fmt.Errorf("storage size %d is not in the allowed range: %d..%d", size, *res.JSON200.StorageRange.Min, *res.JSON200.StorageRange.Max)
 		}
@@ -137,7 +193,7 @@ func (r Resource) validateStorage(ctx context.Context, diags *diag.Diagnostics,
 			return nil
 		}
 	}
-	return fmt.Errorf("couldn't find version '%s'. Available options are:%s\n", storage.Class.ValueString(), opts)
+	return fmt.Errorf("couldn't find storage class '%s'. Available options are:%s\n", storage.Class.ValueString(), opts)
 }
 
 func applyClientResponse(pi *Instance, i *instance.InstancesSingleInstance) error {
@@ -204,3 +260,64 @@ func nullOrValInt64(v *int) basetypes.Int64Value {
 	}
 	return a
 }
+
+// cloneGracePeriod is how long the clone may take to start
+const cloneGracePeriod = 2 * time.Minute
+
+// waitForClone waits until the data of the source instance was copied
+// the instance reports the stale READY status until then
+func waitForClone(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string, timeout time.Duration) error {
+	status := func(ctx context.Context) (string, error) {
+		status, err := getStatus(ctx, c, projectID, instanceID)
+		return status, wait.Retry(err)
+	}
+	return wait.Transition(ctx, fmt.Sprintf("instance %s in project %s to be cloned", instanceID, projectID), timeout, cloneGracePeriod, status, "READY", "FAILED")
+}
+
+// processingGracePeriod is how long a new instance may report the FAILED status before it's processed
+const processingGracePeriod = 2 * time.Minute
+
+// waitForProcessing waits until a new instance no longer reports the FAILED status
+// if the status doesn't change within the grace period, the instance is considered failed by the following wait
+func waitForProcessing(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string, timeout time.Duration) error {
+	if timeout > processingGracePeriod {
+		timeout = processingGracePeriod
+	}
+	err := wait.Until(ctx, fmt.Sprintf("instance %s in project %s to be processed", instanceID, projectID), timeout, func(ctx context.Context) (bool, error) {
+		status, err := getStatus(ctx, c, projectID, instanceID)
+		if err != nil {
+			return false, wait.Retry(err)
+		}
+		return status != "FAILED", nil
+	})
+	if errors.Is(err, wait.ErrTimeout) {
+		return nil
+	}
+	return err
+}
+
+// getStatus returns the upper case status of the instance
+// not found errors are expected right after creation
+func getStatus(ctx context.Context, c *instance.ClientWithResponses, projectID, instanceID string) (string, error) {
+	res, err := c.Get(ctx, projectID, instanceID)
+	if agg := validate.Response(res, err, "JSON200.Item.Status"); agg != nil {
+		return "", agg
+	}
+	return strings.ToUpper(*res.JSON200.Item.Status), nil
+}
+
+// instanceIDByName returns the ID of the instance called name
+func (r Resource) instanceIDByName(ctx context.Context, diags *diag.Diagnostics, projectID, name string) (string, error) {
+	res, err := r.client.MongoDBFlex.Instance.List(ctx, projectID, &instance.ListParams{})
+	if agg := common.Validate(diags, res, err, "JSON200.Items"); agg != nil {
+		return "", agg
+	}
+
+	items := *res.JSON200.Items
+	return common.IDByName("instance", name, len(items), func(i int) (string, string) {
+		if items[i].Name == nil || items[i].ID == nil {
+			return "", ""
+		}
+		return *items[i].Name, *items[i].ID
+	})
+}
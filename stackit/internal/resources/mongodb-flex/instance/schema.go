@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,18 +22,28 @@ import (
 
 // Instance is the schema model
 type Instance struct {
-	ID             types.String      `tfsdk:"id"`
-	Name           types.String      `tfsdk:"name"`
-	ProjectID      types.String      `tfsdk:"project_id"`
-	Type           types.String      `tfsdk:"type"`
-	MachineType    types.String      `tfsdk:"machine_type"` // aka FlavorID
-	Version        types.String      `tfsdk:"version"`
-	Replicas       types.Int64       `tfsdk:"replicas"`
-	BackupSchedule types.String      `tfsdk:"backup_schedule"`
-	Labels         map[string]string `tfsdk:"labels"`
-	ACL            types.Set         `tfsdk:"acl"`
-	Storage        types.Object      `tfsdk:"storage"`
-	Timeouts       timeouts.Value    `tfsdk:"timeouts"`
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	ProjectID          types.String      `tfsdk:"project_id"`
+	Type               types.String      `tfsdk:"type"`
+	MachineType        types.String      `tfsdk:"machine_type"` // aka FlavorID
+	Version            types.String      `tfsdk:"version"`
+	Replicas           types.Int64       `tfsdk:"replicas"`
+	BackupSchedule     types.String      `tfsdk:"backup_schedule"`
+	Labels             map[string]string `tfsdk:"labels"`
+	ACL                types.Set         `tfsdk:"acl"`
+	Storage            types.Object      `tfsdk:"storage"`
+	DeletionProtection types.Bool        `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value    `tfsdk:"timeouts"`
+
+	AllowReplaceOnVersionChange types.Bool   `tfsdk:"allow_replace_on_version_change"`
+	CloneFrom                   types.Object `tfsdk:"clone_from"`
+}
+
+// CloneFrom represents the source of a point-in-time clone
+type CloneFrom struct {
+	InstanceID types.String `tfsdk:"instance_id"`
+	Timestamp  types.String `tfsdk:"timestamp"`
 }
 
 // Storage represent instance storage
@@ -66,11 +77,11 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					validate.ProjectID(),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					common.ReplaceUnlessProtected{},
 				},
 			},
 			"machine_type": schema.StringAttribute{
-				Description: "The Machine Type. Available options: `1.1` (1 CPU, 1 Memory), `1.2` (1 CPU, 2 Memory), `1.4` (1 CPU, 4 Memory), `1.8` (1 CPU, 8 Memory), `2.4` (2 CPU, 4 Memory), `2.8` (2 CPU, 8 Memory), `2.16` (2 CPU, 16 Memory), `4.8` (4 CPU, 8 Memory), `4.16` (4 CPU, 16 Memory), `4.32` (4 CPU, 32 Memory), `8.16` (8 CPU, 16 Memory), `8.32` (8 CPU, 32 Memory), `8.64` (8 CPU, 64 Memory), `16.32` (16 CPU, 32 Memory), `16.64` (16 CPU, 64 Memory)",
+				Description: "The Machine Type. Available options can be listed with the `stackit_mongodb_flex_flavors` data source and are validated at plan time",
 				Required:    true,
 			},
 			"type": schema.StringAttribute{
@@ -82,12 +93,12 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
-					stringplanmodifier.RequiresReplace(),
+					common.ReplaceUnlessProtected{},
 				},
 				Default: stringdefault.StaticString(DefaultType),
 			},
 			"version": schema.StringAttribute{
-				Description: "MongoDB version. Version `5.0`, `6.0`, `7.0` are supported. ",
+				Description: "MongoDB version. Version `5.0`, `6.0`, `7.0` are supported. Upgrades to the next major version are done in place, other changes require `allow_replace_on_version_change` to be set.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
@@ -116,7 +127,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:    true,
 				Attributes: map[string]schema.Attribute{
 					"class": schema.StringAttribute{
-						Description: "Specifies the storage class. Available option: `premium-perf2-mongodb`",
+						Description: "Specifies the storage class (default: `premium-perf2-mongodb`). Available options can be listed with the `stackit_mongodb_flex_storages` data source",
 						Optional:    true,
 						Computed:    true,
 						Default:     stringdefault.StaticString(DefaultStorageClass),
@@ -142,6 +153,33 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:    true,
 				Default:     common.GetDefaultACL(),
 			},
+			"allow_replace_on_version_change": schema.BoolAttribute{
+				Description: "Allow the instance to be recreated when the `version` change can't be applied in place (e.g. a downgrade). Default is `false`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"clone_from": schema.SingleNestedAttribute{
+				Description: "Creates the instance as a point-in-time clone of another instance. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					common.ReplaceUnlessProtected{},
+				},
+				Attributes: map[string]schema.Attribute{
+					"instance_id": schema.StringAttribute{
+						Description: "Specifies the ID of the instance to clone from",
+						Required:    true,
+					},
+					"timestamp": schema.StringAttribute{
+						Description: "Specifies the point in time to clone (RFC3339, e.g. `2023-06-01T10:00:00Z`). If not set, the latest state is cloned",
+						Optional:    true,
+						Validators: []validator.String{
+							validate.RFC3339(),
+						},
+					},
+				},
+			},
+			"deletion_protection": common.DeletionProtection("instance"),
 			"timeouts": common.Timeouts(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -120,6 +120,9 @@ func upgradeV0(ctx context.Context, req resource.UpgradeStateRequest, resp *reso
 		ACL:            acl,
 		Storage:        oldState.Storage,
 		Timeouts:       oldState.Timeouts,
+
+		AllowReplaceOnVersionChange: types.BoolValue(false),
+		CloneFrom:                   types.ObjectNull(cloneFromType),
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
@@ -0,0 +1,104 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/backup"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Restore
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourceInstanceID.IsNull() || plan.SourceInstanceID.IsUnknown() {
+		plan.SourceInstanceID = plan.InstanceID
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	instanceID := plan.InstanceID.ValueString()
+	backupID := plan.BackupID.ValueString()
+	sourceID := plan.SourceInstanceID.ValueString()
+
+	body := backup.InstanceRestoreInstanceRequest{
+		BackupID:   &backupID,
+		InstanceID: &sourceID,
+	}
+
+	res, err := r.client.MongoDBFlex.Backup.Restore(ctx, projectID, instanceID, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		resp.Diagnostics.AddError("failed restoring MongoDB flex backup", agg.Error())
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, 1*time.Hour)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.waitForRestore(ctx, projectID, instanceID, timeout); err != nil {
+		resp.Diagnostics.AddError("failed MongoDB backup restore validation", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s,%s,%s", projectID, instanceID, backupID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read - lifecycle function
+// a restore is a one-off operation, only the existence of the instance it was restored into is checked
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Restore
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.MongoDBFlex.Instance.Get(ctx, state.ProjectID.ValueString(), state.InstanceID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200.Item"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading MongoDB flex instance", agg.Error())
+		return
+	}
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Restore
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// restored data is kept on the instance
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+// importing records a restore that already happened without running it again
+func (r Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, ok := common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("instance_id"), common.ImportString("backup_id"))
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_instance_id"), id.Get("instance_id"))...)
+}
@@ -0,0 +1,27 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/wait"
+)
+
+// restoreGracePeriod is how long the restore may take to start
+// until then the instance reports the stale READY status
+const restoreGracePeriod = 2 * time.Minute
+
+// waitForRestore waits until the backup was restored into the instance
+func (r Resource) waitForRestore(ctx context.Context, projectID, instanceID string, timeout time.Duration) error {
+	status := func(ctx context.Context) (string, error) {
+		res, err := r.client.MongoDBFlex.Instance.Get(ctx, projectID, instanceID)
+		if agg := validate.Response(res, err, "JSON200.Item.Status"); agg != nil {
+			return "", wait.Retry(agg)
+		}
+		return strings.ToUpper(*res.JSON200.Item.Status), nil
+	}
+	return wait.Transition(ctx, fmt.Sprintf("backup to be restored into instance %s in project %s", instanceID, projectID), timeout, restoreGracePeriod, status, "READY", "FAILED")
+}
@@ -0,0 +1,72 @@
+package restore_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_MongoDBFlexRestore(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(7, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("stackit_mongodb_flex_restore.example", "id"),
+					resource.TestCheckResourceAttrPair("stackit_mongodb_flex_restore.example", "source_instance_id", "stackit_mongodb_flex_instance.example", "id"),
+				),
+			},
+			{
+				ResourceName:            "stackit_mongodb_flex_restore.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func config(name string) string {
+	return fmt.Sprintf(`
+	resource "stackit_mongodb_flex_instance" "example" {
+		name         = "%s"
+		project_id   = "%s"
+		machine_type = "1.1"
+		acl = ["193.148.160.0/19","45.129.40.1/21"]
+	}
+
+	data "stackit_mongodb_flex_backups" "example" {
+		project_id  = "%s"
+		instance_id = stackit_mongodb_flex_instance.example.id
+	}
+
+	resource "stackit_mongodb_flex_restore" "example" {
+		project_id  = "%s"
+		instance_id = stackit_mongodb_flex_instance.example.id
+		backup_id   = data.stackit_mongodb_flex_backups.example.backups[0].id
+	}
+	`,
+		name,
+		common.GetAcceptanceTestsProjectID(),
+		common.GetAcceptanceTestsProjectID(),
+		common.GetAcceptanceTestsProjectID(),
+	)
+}
@@ -0,0 +1,143 @@
+package role
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/role"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Role
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privileges := plan.preparePrivileges(ctx, &resp.Diagnostics)
+	inherited := plan.prepareInheritedRoles(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	database := plan.Database.ValueString()
+	body := role.InstanceCreateRoleRequest{
+		Name:       &name,
+		Database:   &database,
+		Privileges: &privileges,
+		Roles:      &inherited,
+	}
+
+	res, err := r.client.MongoDBFlex.Role.Create(ctx, plan.ProjectID.ValueString(), plan.InstanceID.ValueString(), body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON202.Item"); agg != nil {
+		resp.Diagnostics.AddError("failed creating mongodb flex role", agg.Error())
+		return
+	}
+
+	plan.parse(ctx, res.JSON202.Item, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Role
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.MongoDBFlex.Role.Get(ctx, state.ProjectID.ValueString(), state.InstanceID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200.Item"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed making read role request", agg.Error())
+		return
+	}
+
+	state.parse(ctx, res.JSON200.Item, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Role
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	privileges := plan.preparePrivileges(ctx, &resp.Diagnostics)
+	inherited := plan.prepareInheritedRoles(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := role.InstanceUpdateRoleRequest{
+		Privileges: &privileges,
+		Roles:      &inherited,
+	}
+
+	res, err := r.client.MongoDBFlex.Role.Put(ctx, plan.ProjectID.ValueString(), plan.InstanceID.ValueString(), plan.ID.ValueString(), body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200.Item"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed updating mongodb flex role", agg.Error())
+		return
+	}
+
+	plan.parse(ctx, res.JSON200.Item, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// update state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Role
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.MongoDBFlex.Role.Delete(ctx, state.ProjectID.ValueString(), state.InstanceID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to delete role", agg.Error())
+		resp.Diagnostics.AddWarning("remove role assignments", "failure to delete a role usually means the role is still assigned to a user")
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("mongodb_instance_id", "instance_id"), common.ImportString("database"), common.ImportString("role_name", "id", "name"))
+}
@@ -0,0 +1,123 @@
+package role
+
+import (
+	"context"
+	"sort"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/role"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	DefaultDatabase = "admin"
+)
+
+func (r *Role) preparePrivileges(ctx context.Context, diags *diag.Diagnostics) []role.InstancePrivilege {
+	privileges := []role.InstancePrivilege{}
+	var ps []Privilege
+	diags.Append(r.Privileges.ElementsAs(ctx, &ps, false)...)
+	if diags.HasError() {
+		return nil
+	}
+	for _, p := range ps {
+		actions := []string{}
+		diags.Append(p.Actions.ElementsAs(ctx, &actions, false)...)
+		sort.Strings(actions)
+		privileges = append(privileges, role.InstancePrivilege{
+			Resource: &role.InstanceResource{
+				Db:         strPtrOrNil(p.Database),
+				Collection: strPtrOrNil(p.Collection),
+			},
+			Actions: &actions,
+		})
+	}
+	return privileges
+}
+
+func (r *Role) prepareInheritedRoles(ctx context.Context, diags *diag.Diagnostics) []role.InstanceInheritedRole {
+	roles := []role.InstanceInheritedRole{}
+	if r.InheritedRoles.IsNull() || r.InheritedRoles.IsUnknown() {
+		return roles
+	}
+	var irs []InheritedRole
+	diags.Append(r.InheritedRoles.ElementsAs(ctx, &irs, false)...)
+	for _, ir := range irs {
+		roles = append(roles, role.InstanceInheritedRole{
+			Role: strPtrOrNil(ir.Role),
+			Db:   strPtrOrNil(ir.Database),
+		})
+	}
+	return roles
+}
+
+func (r *Role) parse(ctx context.Context, item *role.InstanceRole, diags *diag.Diagnostics) {
+	r.Name = resToStr(item.Name)
+	r.ID = r.Name
+	if item.Database != nil {
+		r.Database = resToStr(item.Database)
+	}
+
+	privileges := []attr.Value{}
+	if item.Privileges != nil {
+		for _, p := range *item.Privileges {
+			attrs := map[string]attr.Value{
+				"database":   types.StringNull(),
+				"collection": types.StringNull(),
+				"actions":    types.SetValueMust(types.StringType, []attr.Value{}),
+			}
+			if p.Resource != nil {
+				attrs["database"] = resToStr(p.Resource.Db)
+				// an empty collection means all collections of the database
+				if p.Resource.Collection != nil && *p.Resource.Collection != "" {
+					attrs["collection"] = resToStr(p.Resource.Collection)
+				}
+			}
+			if p.Actions != nil {
+				actions := []attr.Value{}
+				for _, a := range *p.Actions {
+					actions = append(actions, types.StringValue(a))
+				}
+				attrs["actions"] = types.SetValueMust(types.StringType, actions)
+			}
+			privileges = append(privileges, types.ObjectValueMust(privilegeType, attrs))
+		}
+	}
+	v, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: privilegeType}, privileges)
+	diags.Append(d...)
+	r.Privileges = v
+
+	if item.Roles == nil || len(*item.Roles) == 0 {
+		if !r.InheritedRoles.IsNull() {
+			r.InheritedRoles = types.SetValueMust(types.ObjectType{AttrTypes: inheritedRoleType}, []attr.Value{})
+		}
+		return
+	}
+	roles := []attr.Value{}
+	for _, ir := range *item.Roles {
+		roles = append(roles, types.ObjectValueMust(inheritedRoleType, map[string]attr.Value{
+			"role":     resToStr(ir.Role),
+			"database": resToStr(ir.Db),
+		}))
+	}
+	s, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: inheritedRoleType}, roles)
+	diags.Append(d...)
+	r.InheritedRoles = s
+}
+
+func strPtrOrNil(f basetypes.StringValue) *string {
+	if f.IsNull() || f.IsUnknown() {
+		return nil
+	}
+	v := f.ValueString()
+	return &v
+}
+
+func resToStr(f *string) basetypes.StringValue {
+	if f == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(*f)
+}
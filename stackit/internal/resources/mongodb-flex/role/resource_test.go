@@ -0,0 +1,112 @@
+package role_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_MongoDBFlexRole(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(7, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name, "find"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_mongodb_flex_role.example", "name", "reporting"),
+					resource.TestCheckResourceAttr("stackit_mongodb_flex_role.example", "database", "admin"),
+					resource.TestCheckResourceAttr("stackit_mongodb_flex_role.example", "privileges.#", "2"),
+					resource.TestCheckResourceAttr("stackit_mongodb_flex_user.example", "roles.0", "read"),
+					resource.TestCheckResourceAttr("stackit_mongodb_flex_user.example", "custom_roles.0", "reporting"),
+				),
+			},
+			// update privileges in place
+			{
+				Config: config(name, "insert"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_mongodb_flex_role.example", "name", "reporting"),
+					resource.TestCheckResourceAttr("stackit_mongodb_flex_role.example", "privileges.#", "2"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_mongodb_flex_role.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_mongodb_flex_instance.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_mongodb_flex_instance.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+					return fmt.Sprintf("%s,%s,admin,reporting", common.GetAcceptanceTestsProjectID(), id), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(name, action string) string {
+	return fmt.Sprintf(`
+	resource "stackit_mongodb_flex_instance" "example" {
+	 	name         = "%s"
+	 	project_id   = "%s"
+	 	machine_type = "1.1"
+		acl = ["193.148.160.0/19","45.129.40.1/21"]
+	}
+
+	resource "stackit_mongodb_flex_role" "example" {
+		project_id  = "%s"
+		instance_id = stackit_mongodb_flex_instance.example.id
+		name        = "reporting"
+		privileges  = [
+			{
+				database   = "sales"
+				collection = "orders"
+				actions    = ["find", "%s"]
+			},
+			{
+				database = "analytics"
+				actions  = ["find"]
+			}
+		]
+	}
+
+	resource "stackit_mongodb_flex_user" "example" {
+		project_id  = "%s"
+		instance_id = stackit_mongodb_flex_instance.example.id
+		database    = "admin"
+		roles        = ["read"]
+		custom_roles = [stackit_mongodb_flex_role.example.name]
+	}
+	  `,
+		name,
+		common.GetAcceptanceTestsProjectID(),
+		common.GetAcceptanceTestsProjectID(),
+		action,
+		common.GetAcceptanceTestsProjectID(),
+	)
+}
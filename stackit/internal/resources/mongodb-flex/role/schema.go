@@ -0,0 +1,148 @@
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Role is the schema model
+type Role struct {
+	ID             types.String `tfsdk:"id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	InstanceID     types.String `tfsdk:"instance_id"`
+	Name           types.String `tfsdk:"name"`
+	Database       types.String `tfsdk:"database"`
+	Privileges     types.List   `tfsdk:"privileges"`
+	InheritedRoles types.Set    `tfsdk:"inherited_roles"`
+}
+
+// Privilege represents actions allowed on a database or collection
+type Privilege struct {
+	Database   types.String `tfsdk:"database"`
+	Collection types.String `tfsdk:"collection"`
+	Actions    types.Set    `tfsdk:"actions"`
+}
+
+var privilegeType = map[string]attr.Type{
+	"database":   types.StringType,
+	"collection": types.StringType,
+	"actions":    types.SetType{ElemType: types.StringType},
+}
+
+// InheritedRole represents a role the custom role inherits from
+type InheritedRole struct {
+	Role     types.String `tfsdk:"role"`
+	Database types.String `tfsdk:"database"`
+}
+
+var inheritedRoleType = map[string]attr.Type{
+	"role":     types.StringType,
+	"database": types.StringType,
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages MongoDB Flex custom roles. Custom roles can be assigned to `stackit_mongodb_flex_user` by name\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project ID the instance runs in. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "the mongo db flex instance id. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Specifies the role name. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: fmt.Sprintf("Specifies the database the role is defined in (Default is `%s`). Changing this value requires the resource to be recreated.", DefaultDatabase),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Default: stringdefault.StaticString(DefaultDatabase),
+			},
+			"privileges": schema.ListNestedAttribute{
+				Description: "Specifies the privileges granted by the role",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"database": schema.StringAttribute{
+							Description: "Specifies the database the privilege applies to",
+							Required:    true,
+						},
+						"collection": schema.StringAttribute{
+							Description: "Specifies the collection the privilege applies to. If not set, the privilege applies to all collections of the database",
+							Optional:    true,
+						},
+						"actions": schema.SetAttribute{
+							Description: "Specifies the allowed actions, e.g. `find`, `insert`, `update`, `remove`",
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"inherited_roles": schema.SetNestedAttribute{
+				Description: "Specifies roles from which this role inherits privileges",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Description: "Specifies the name of the inherited role",
+							Required:    true,
+						},
+						"database": schema.StringAttribute{
+							Description: "Specifies the database of the inherited role",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
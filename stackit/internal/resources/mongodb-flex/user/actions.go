@@ -4,14 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/user"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -29,8 +25,7 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	username := plan.Username.ValueString()
 	database := plan.Database.ValueString()
 
-	var roles []string
-	resp.Diagnostics.Append(plan.Roles.ElementsAs(ctx, &roles, true)...)
+	roles := plan.apiRoles(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -47,12 +42,6 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	}
 
 	item := *res.JSON202.Item
-	elems := []attr.Value{}
-	if *res.JSON202.Item.Roles != nil {
-		for _, v := range *res.JSON202.Item.Roles {
-			elems = append(elems, types.StringValue(v))
-		}
-	}
 	if res.JSON202.Item.Password == nil {
 		resp.Diagnostics.AddError("received an empty password", fmt.Sprintf("full response: %+v", res.JSON202))
 		return
@@ -67,7 +56,9 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	plan.Host = nullOrValStr(item.Host)
 	plan.Port = nullOrValInt64(item.Port)
 	plan.URI = nullOrValStr(item.Uri)
-	plan.Roles = types.ListValueMust(types.StringType, elems)
+	if item.Roles != nil {
+		plan.setRoles(*item.Roles)
+	}
 
 	// update state with user
 	diags = resp.State.Set(ctx, &plan)
@@ -105,13 +96,11 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 	state.Host = nullOrValStr(item.Host)
 	state.Port = nullOrValInt64(item.Port)
 	state.Database = nullOrValStr(item.Database)
-	roles := []attr.Value{}
-	if r := item.Roles; r != nil {
-		for _, v := range *r {
-			roles = append(roles, types.StringValue(v))
-		}
+	roles := []string{}
+	if item.Roles != nil {
+		roles = *item.Roles
 	}
-	state.Roles = types.ListValueMust(types.StringType, roles)
+	state.setRoles(roles)
 	if state.URI.IsUnknown() {
 		state.URI = types.StringNull()
 	}
@@ -129,6 +118,56 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 
 // Update - lifecycle function
 func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state User
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.Host = state.Host
+	plan.Port = state.Port
+	plan.Password = state.Password
+	plan.URI = state.URI
+
+	c := r.client.MongoDBFlex.User
+	projectID, instanceID, userID := plan.ProjectID.ValueString(), plan.InstanceID.ValueString(), plan.ID.ValueString()
+
+	// update roles in place
+	if !plan.Roles.Equal(state.Roles) || !plan.CustomRoles.Equal(state.CustomRoles) {
+		roles := plan.apiRoles(ctx, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		database := plan.Database.ValueString()
+		body := user.InstancePartialUpdateUserRequest{
+			Database: &database,
+			Roles:    &roles,
+		}
+		res, err := c.Patch(ctx, projectID, instanceID, userID, body)
+		if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+			resp.Diagnostics.AddError("failed updating mongodb flex db user roles", agg.Error())
+			return
+		}
+	}
+
+	// reset password while keeping the user and its grants
+	if !plan.PasswordRotationTrigger.Equal(state.PasswordRotationTrigger) {
+		res, err := c.ResetPassword(ctx, projectID, instanceID, userID)
+		if agg := common.Validate(&resp.Diagnostics, res, err, "JSON202.Item.Password"); agg != nil {
+			resp.Diagnostics.AddError("failed resetting mongodb flex db user password", agg.Error())
+			return
+		}
+		plan.Password = nullOrValStr(res.JSON202.Item.Password)
+		if res.JSON202.Item.Uri != nil {
+			plan.URI = nullOrValStr(res.JSON202.Item.Uri)
+		}
+	}
+
+	// update state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Delete - lifecycle function
@@ -154,33 +193,7 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 // ImportState handles terraform import
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,mongodb_instance_id,user_id`.\nInstead got: %q", req.ID),
-		)
-		return
-	}
-
-	// validate project id
-	if err := clientValidate.ProjectID(idParts[0]); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
-		return
-	}
-
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[2])...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("mongodb_instance_id", "instance_id"), common.ImportString("user_id", "id"))
 }
 
 func nullOrValStr(v *string) basetypes.StringValue {
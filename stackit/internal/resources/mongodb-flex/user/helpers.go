@@ -1,7 +1,57 @@
 package user
 
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
 const (
 	DefaultUsername = "stackit"
 	DefaultDatabase = "stackit"
 	DefaultRole     = "readWrite"
 )
+
+// BuiltinRoles lists the roles provided by the service, other assigned roles are custom roles
+var BuiltinRoles = []string{"readWrite", "read", "readAnyDatabase", "readWriteAnyDatabase", "stackitAdmin"}
+
+// SplitRoles separates the roles returned by the API into built-in and custom roles
+func SplitRoles(roles []string) (builtin, custom []attr.Value) {
+	builtin, custom = []attr.Value{}, []attr.Value{}
+	for _, v := range roles {
+		if slices.Contains(BuiltinRoles, v) {
+			builtin = append(builtin, types.StringValue(v))
+			continue
+		}
+		custom = append(custom, types.StringValue(v))
+	}
+	return builtin, custom
+}
+
+// setRoles sets roles and custom_roles from the roles returned by the API
+// custom_roles stays null if it isn't configured and no custom roles are assigned
+func (u *User) setRoles(roles []string) {
+	builtin, custom := SplitRoles(roles)
+	u.Roles = types.ListValueMust(types.StringType, builtin)
+	if len(custom) == 0 && u.CustomRoles.IsNull() {
+		return
+	}
+	u.CustomRoles = types.SetValueMust(types.StringType, custom)
+}
+
+// apiRoles merges roles and custom_roles into the roles sent to the API
+func (u *User) apiRoles(ctx context.Context, diags *diag.Diagnostics) []string {
+	roles := []string{}
+	diags.Append(u.Roles.ElementsAs(ctx, &roles, true)...)
+	if u.CustomRoles.IsNull() || u.CustomRoles.IsUnknown() {
+		return roles
+	}
+	custom := []string{}
+	diags.Append(u.CustomRoles.ElementsAs(ctx, &custom, true)...)
+	sort.Strings(custom)
+	return append(roles, custom...)
+}
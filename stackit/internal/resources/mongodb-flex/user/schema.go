@@ -7,6 +7,7 @@ import (
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,16 +22,19 @@ import (
 
 // User is the schema model
 type User struct {
-	ID         types.String `tfsdk:"id"`
-	InstanceID types.String `tfsdk:"instance_id"`
-	ProjectID  types.String `tfsdk:"project_id"`
-	Password   types.String `tfsdk:"password"`
-	Username   types.String `tfsdk:"username"`
-	Database   types.String `tfsdk:"database"`
-	Host       types.String `tfsdk:"host"`
-	Port       types.Int64  `tfsdk:"port"`
-	URI        types.String `tfsdk:"uri"`
-	Roles      types.List   `tfsdk:"roles"`
+	ID          types.String `tfsdk:"id"`
+	InstanceID  types.String `tfsdk:"instance_id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	Password    types.String `tfsdk:"password"`
+	Username    types.String `tfsdk:"username"`
+	Database    types.String `tfsdk:"database"`
+	Host        types.String `tfsdk:"host"`
+	Port        types.Int64  `tfsdk:"port"`
+	URI         types.String `tfsdk:"uri"`
+	Roles       types.List   `tfsdk:"roles"`
+	CustomRoles types.Set    `tfsdk:"custom_roles"`
+
+	PasswordRotationTrigger types.Map `tfsdk:"password_rotation_trigger"`
 }
 
 // Schema returns the terraform schema structure
@@ -77,6 +81,14 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Description: "Specifies the user's password",
 				Computed:    true,
 				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password_rotation_trigger": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, resets the user's password without recreating the user",
+				ElementType: types.StringType,
+				Optional:    true,
 			},
 			"database": schema.StringAttribute{
 				Description: "Specifies the database the user can access",
@@ -99,22 +111,36 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Description: "Specifies connection URI",
 				Computed:    true,
 				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"roles": schema.ListAttribute{
-				Description: "Specifies the role assigned to the user, valid options are: `readWrite` or `read`",
+				Description: "Specifies the built-in roles assigned to the user, valid options are: `readWrite`, `read`, `readAnyDatabase`, `readWriteAnyDatabase` and `stackitAdmin`",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
 				Validators: []validator.List{
 					listvalidator.ValueStringsAre(
-						stringvalidator.OneOf("readWrite", "read", "readAnyDatabase", "readWriteAnyDatabase", "stackitAdmin"),
+						stringvalidator.OneOf(BuiltinRoles...),
 					),
-					listvalidator.SizeAtMost(1),
+					listvalidator.UniqueValues(),
 				},
 				Default: listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{
 					types.StringValue(DefaultRole),
 				})),
 			},
+			"custom_roles": schema.SetAttribute{
+				Description: "Specifies the names of custom roles created with `stackit_mongodb_flex_role` assigned to the user, to grant access across multiple databases",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.NoneOf(BuiltinRoles...),
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
 		},
 	}
 }
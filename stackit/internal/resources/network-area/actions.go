@@ -0,0 +1,394 @@
+package networkarea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"time"
+
+	iaas_area "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/area"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NetworkArea
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ranges := []iaas_area.V1NetworkRange{}
+	for _, key := range wantedRanges(plan) {
+		ranges = append(ranges, iaas_area.V1NetworkRange{Prefix: key})
+	}
+	routes := []iaas_area.V1Route{}
+	for _, key := range wantedRoutes(plan) {
+		prefix, nextHop := parseRouteKey(key)
+		routes = append(routes, iaas_area.V1Route{Prefix: prefix, Nexthop: nextHop})
+	}
+
+	ns := nameservers(ctx, plan.DefaultNameServers)
+	dpl := int(plan.DefaultPrefixLength.ValueInt64())
+	minpl := int(plan.MinPrefixLength.ValueInt64())
+	maxpl := int(plan.MaxPrefixLength.ValueInt64())
+	body := iaas_area.V1CreateAreaJSONRequestBody{
+		Name: plan.Name.ValueString(),
+		AddressFamily: iaas_area.V1CreateAreaAddressFamily{
+			Ipv4: iaas_area.V1CreateAreaIPv4{
+				NetworkRanges:      ranges,
+				TransferNetwork:    plan.TransferNetwork.ValueString(),
+				DefaultNameservers: ns,
+				DefaultPrefixLen:   &dpl,
+				MinPrefixLen:       &minpl,
+				MaxPrefixLen:       &maxpl,
+				Routes:             &routes,
+			},
+		},
+		Labels: common.FromLabels(plan.Labels),
+	}
+
+	organizationID := uuid.MustParse(plan.OrganizationID.ValueString())
+	res, err := r.client.IAAS.Area.V1CreateArea(ctx, organizationID, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed creating network area", agg.Error())
+		return
+	}
+
+	areaID := res.JSON201.AreaID
+	plan.ID = types.StringValue(areaID.String())
+
+	// save the ID, so the network area isn't lost if waiting fails
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), plan.OrganizationID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, 10*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	process := res.WaitHandler(ctx, r.client.IAAS.Area, organizationID, areaID).SetTimeout(timeout)
+	wr, err := process.WaitWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed validating network area creation", err.Error())
+		return
+	}
+
+	a, ok := wr.(iaas_area.V1NetworkArea)
+	if !ok {
+		resp.Diagnostics.AddError("failed wait result conversion", "result is not of iaas_area.V1NetworkArea")
+		return
+	}
+
+	resp.Diagnostics.Append(transform(&plan, a)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// nameservers returns the sorted nameservers of the set, or nil to use the API default
+func nameservers(ctx context.Context, set types.Set) *[]string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	ns := []string{}
+	for _, v := range set.Elements() {
+		s, err := common.ToString(ctx, v)
+		if err != nil {
+			continue
+		}
+		ns = append(ns, s)
+	}
+	sort.Strings(ns)
+	return &ns
+}
+
+func wantedRanges(plan NetworkArea) []string {
+	keys := []string{}
+	for _, r := range plan.NetworkRanges {
+		keys = append(keys, common.CanonicalCIDR(r.ValueString()))
+	}
+	return keys
+}
+
+func wantedRoutes(plan NetworkArea) []string {
+	keys := []string{}
+	for _, r := range plan.Routes {
+		keys = append(keys, routeKey(r.Prefix.ValueString(), r.NextHop.ValueString()))
+	}
+	return keys
+}
+
+func transform(state *NetworkArea, a iaas_area.V1NetworkArea) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(a.AreaID.String())
+	state.Name = types.StringValue(a.Name)
+	state.Labels = common.ToLabels(a.Labels)
+	if a.Ipv4 == nil {
+		return diags
+	}
+
+	// keep the configured notation of ranges and routes if they're equal
+	configured := map[string]types.String{}
+	for _, r := range state.NetworkRanges {
+		configured[common.CanonicalCIDR(r.ValueString())] = r
+	}
+	ranges := []types.String{}
+	for _, r := range a.Ipv4.NetworkRanges {
+		if v, ok := configured[common.CanonicalCIDR(r.Prefix)]; ok {
+			ranges = append(ranges, v)
+			continue
+		}
+		ranges = append(ranges, types.StringValue(r.Prefix))
+	}
+	state.NetworkRanges = ranges
+
+	configuredRoutes := map[string]Route{}
+	for _, r := range state.Routes {
+		configuredRoutes[routeKey(r.Prefix.ValueString(), r.NextHop.ValueString())] = r
+	}
+	var routes []Route
+	if a.Ipv4.Routes != nil {
+		for _, r := range *a.Ipv4.Routes {
+			if v, ok := configuredRoutes[routeKey(r.Prefix, r.Nexthop)]; ok {
+				routes = append(routes, v)
+				continue
+			}
+			routes = append(routes, Route{
+				Prefix:  types.StringValue(r.Prefix),
+				NextHop: types.StringValue(r.Nexthop),
+			})
+		}
+	}
+	if routes == nil && state.Routes != nil {
+		routes = []Route{}
+	}
+	state.Routes = routes
+
+	state.TransferNetwork = types.StringValue(a.Ipv4.TransferNetwork)
+	ns := []attr.Value{}
+	if a.Ipv4.DefaultNameservers != nil {
+		for _, v := range *a.Ipv4.DefaultNameservers {
+			ns = append(ns, types.StringValue(v))
+		}
+	}
+	var d diag.Diagnostics
+	state.DefaultNameServers, d = types.SetValue(types.StringType, ns)
+	diags.Append(d...)
+
+	if a.Ipv4.DefaultPrefixLen != nil {
+		state.DefaultPrefixLength = types.Int64Value(int64(*a.Ipv4.DefaultPrefixLen))
+	}
+	if a.Ipv4.MinPrefixLen != nil {
+		state.MinPrefixLength = types.Int64Value(int64(*a.Ipv4.MinPrefixLen))
+	}
+	if a.Ipv4.MaxPrefixLen != nil {
+		state.MaxPrefixLength = types.Int64Value(int64(*a.Ipv4.MaxPrefixLen))
+	}
+	return diags
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NetworkArea
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID := uuid.MustParse(state.OrganizationID.ValueString())
+	areaID := uuid.MustParse(state.ID.ValueString())
+	res, err := r.client.IAAS.Area.V1GetArea(ctx, organizationID, areaID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading network area", agg.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(transform(&state, *res.JSON200)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NetworkArea
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID := uuid.MustParse(state.OrganizationID.ValueString())
+	areaID := uuid.MustParse(state.ID.ValueString())
+
+	if !plan.Name.Equal(state.Name) ||
+		!plan.DefaultNameServers.Equal(state.DefaultNameServers) ||
+		!plan.DefaultPrefixLength.Equal(state.DefaultPrefixLength) ||
+		!plan.MinPrefixLength.Equal(state.MinPrefixLength) ||
+		!plan.MaxPrefixLength.Equal(state.MaxPrefixLength) ||
+		!reflect.DeepEqual(plan.Labels, state.Labels) {
+		name := plan.Name.ValueString()
+		dpl := int(plan.DefaultPrefixLength.ValueInt64())
+		minpl := int(plan.MinPrefixLength.ValueInt64())
+		maxpl := int(plan.MaxPrefixLength.ValueInt64())
+		res, err := r.client.IAAS.Area.V1UpdateArea(ctx, organizationID, areaID, iaas_area.V1UpdateAreaJSONRequestBody{
+			Name: &name,
+			AddressFamily: &iaas_area.V1UpdateAreaAddressFamily{
+				Ipv4: &iaas_area.V1UpdateAreaIPv4{
+					DefaultNameservers: nameservers(ctx, plan.DefaultNameServers),
+					DefaultPrefixLen:   &dpl,
+					MinPrefixLen:       &minpl,
+					MaxPrefixLen:       &maxpl,
+				},
+			},
+			Labels: common.FromLabels(plan.Labels),
+		})
+		if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+			resp.Diagnostics.AddError("failed updating network area", agg.Error())
+			return
+		}
+	}
+
+	res, err := r.client.IAAS.Area.V1GetArea(ctx, organizationID, areaID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		resp.Diagnostics.AddError("failed reading network area", agg.Error())
+		return
+	}
+
+	// new ranges and routes are added before old ones are removed
+	r.updateRanges(ctx, &resp.Diagnostics, organizationID, areaID, *res.JSON200, wantedRanges(plan))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.updateRoutes(ctx, &resp.Diagnostics, organizationID, areaID, *res.JSON200, wantedRoutes(plan))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err = r.client.IAAS.Area.V1GetArea(ctx, organizationID, areaID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		resp.Diagnostics.AddError("failed reading network area", agg.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(transform(&plan, *res.JSON200)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r Resource) updateRanges(ctx context.Context, diags *diag.Diagnostics, organizationID, areaID uuid.UUID, a iaas_area.V1NetworkArea, want []string) {
+	current := []entry{}
+	if a.Ipv4 != nil {
+		for _, nr := range a.Ipv4.NetworkRanges {
+			current = append(current, entry{ID: nr.NetworkRangeID, Key: common.CanonicalCIDR(nr.Prefix)})
+		}
+	}
+
+	create, remove := common.DiffEntries(current, entryKey, want)
+	if len(create) > 0 {
+		ranges := []iaas_area.V1NetworkRange{}
+		for _, key := range create {
+			ranges = append(ranges, iaas_area.V1NetworkRange{Prefix: key})
+		}
+		res, err := r.client.IAAS.Area.V1CreateAreaRanges(ctx, organizationID, areaID, iaas_area.V1CreateAreaRangesJSONRequestBody{
+			Ipv4: ranges,
+		})
+		if agg := common.Validate(diags, res, err); agg != nil {
+			diags.AddError("failed creating network ranges", agg.Error())
+			return
+		}
+	}
+
+	for _, e := range remove {
+		res, err := r.client.IAAS.Area.V1DeleteAreaRange(ctx, organizationID, areaID, e.ID)
+		if agg := common.Validate(diags, res, err); agg != nil {
+			diags.AddError(fmt.Sprintf("failed deleting network range %s", e.Key), agg.Error())
+			return
+		}
+	}
+}
+
+func (r Resource) updateRoutes(ctx context.Context, diags *diag.Diagnostics, organizationID, areaID uuid.UUID, a iaas_area.V1NetworkArea, want []string) {
+	current := []entry{}
+	if a.Ipv4 != nil && a.Ipv4.Routes != nil {
+		for _, rt := range *a.Ipv4.Routes {
+			current = append(current, entry{ID: rt.RouteID, Key: routeKey(rt.Prefix, rt.Nexthop)})
+		}
+	}
+
+	create, remove := common.DiffEntries(current, entryKey, want)
+	if len(create) > 0 {
+		routes := []iaas_area.V1Route{}
+		for _, key := range create {
+			prefix, nextHop := parseRouteKey(key)
+			routes = append(routes, iaas_area.V1Route{Prefix: prefix, Nexthop: nextHop})
+		}
+		res, err := r.client.IAAS.Area.V1CreateAreaRoutes(ctx, organizationID, areaID, iaas_area.V1CreateAreaRoutesJSONRequestBody{
+			Ipv4: routes,
+		})
+		if agg := common.Validate(diags, res, err); agg != nil {
+			diags.AddError("failed creating routes", agg.Error())
+			return
+		}
+	}
+
+	for _, e := range remove {
+		res, err := r.client.IAAS.Area.V1DeleteAreaRoute(ctx, organizationID, areaID, e.ID)
+		if agg := common.Validate(diags, res, err); agg != nil {
+			diags.AddError(fmt.Sprintf("failed deleting route %s", e.Key), agg.Error())
+			return
+		}
+	}
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NetworkArea
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID := uuid.MustParse(state.OrganizationID.ValueString())
+	areaID := uuid.MustParse(state.ID.ValueString())
+	res, err := r.client.IAAS.Area.V1DeleteArea(ctx, organizationID, areaID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed deleting network area", agg.Error())
+		return
+	}
+
+	timeout, d := state.Timeouts.Delete(ctx, 10*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	process := res.WaitHandler(ctx, r.client.IAAS.Area, organizationID, areaID).SetTimeout(timeout)
+	if _, err := process.WaitWithContext(ctx); err != nil {
+		resp.Diagnostics.AddError("failed to verify network area deletion", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, common.ImportUUID("organization_id"), common.ImportUUID("id"))
+}
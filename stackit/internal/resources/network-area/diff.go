@@ -0,0 +1,30 @@
+package networkarea
+
+import (
+	"strings"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+)
+
+// entry is an existing network range or route of the area
+type entry struct {
+	ID  uuid.UUID
+	Key string
+}
+
+// entryKey returns the key entries are compared by
+func entryKey(e entry) string {
+	return e.Key
+}
+
+// routeKey identifies a route by its destination and next hop
+func routeKey(prefix, nextHop string) string {
+	return common.CanonicalCIDR(prefix) + " via " + nextHop
+}
+
+// parseRouteKey returns the destination and next hop of a route key
+func parseRouteKey(key string) (string, string) {
+	prefix, nextHop, _ := strings.Cut(key, " via ")
+	return prefix, nextHop
+}
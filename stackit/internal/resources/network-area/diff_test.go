@@ -0,0 +1,42 @@
+package networkarea
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+)
+
+func TestDiffRoutes(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	id := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	current := []entry{{ID: id, Key: routeKey("10.10.0.0/16", "10.0.0.1")}}
+	want := []string{routeKey("10.10.0.1/16", "10.0.0.2"), routeKey("10.10.0.1/16", "10.0.0.1")}
+
+	create, remove := common.DiffEntries(current, entryKey, want)
+	if wantCreate := []string{"10.10.0.0/16 via 10.0.0.2"}; !reflect.DeepEqual(create, wantCreate) {
+		t.Errorf("DiffEntries() create = %v, want %v", create, wantCreate)
+	}
+	if wantRemove := []entry{}; !reflect.DeepEqual(remove, wantRemove) {
+		t.Errorf("DiffEntries() remove = %v, want %v", remove, wantRemove)
+	}
+}
+
+func TestParseRouteKey(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	prefix, nextHop := parseRouteKey(routeKey("192.168.1.0/24", "10.0.0.1"))
+	if prefix != "192.168.1.0/24" || nextHop != "10.0.0.1" {
+		t.Errorf("parseRouteKey() = %s, %s", prefix, nextHop)
+	}
+}
@@ -0,0 +1,105 @@
+package networkarea_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_NetworkArea(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name, `"10.0.0.0/16"`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_network_area.example", "name", name),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "organization_id", common.GetAcceptanceTestsOrganizationID()),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "network_ranges.#", "1"),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "transfer_network", "10.255.255.0/24"),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "default_prefix_length", "25"),
+					resource.TestCheckResourceAttrSet("stackit_network_area.example", "id"),
+				),
+			},
+			// check adding ranges and routes
+			{
+				Config: config(name, `"10.0.0.0/16", "10.1.0.0/16"`, `
+	routes = [{
+		prefix   = "192.168.0.0/24"
+		next_hop = "10.0.0.10"
+	}]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_network_area.example", "network_ranges.#", "2"),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "routes.#", "1"),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "routes.0.prefix", "192.168.0.0/24"),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "routes.0.next_hop", "10.0.0.10"),
+				),
+			},
+			// check removing ranges and routes
+			{
+				Config: config(name, `"10.1.0.0/16"`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_network_area.example", "network_ranges.#", "1"),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "network_ranges.0", "10.1.0.0/16"),
+					resource.TestCheckNoResourceAttr("stackit_network_area.example", "routes"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_network_area.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_network_area.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_network_area.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+
+					return fmt.Sprintf("%s,%s", common.GetAcceptanceTestsOrganizationID(), id), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func config(name, ranges, routes string) string {
+	return fmt.Sprintf(`
+resource "stackit_network_area" "example" {
+	organization_id  = "%s"
+	name             = "%s"
+	network_ranges   = [%s]
+	transfer_network = "10.255.255.0/24"
+	%s
+}
+	  `,
+		common.GetAcceptanceTestsOrganizationID(),
+		name,
+		ranges,
+		routes,
+	)
+}
@@ -0,0 +1,170 @@
+package networkarea
+
+import (
+	"context"
+	"fmt"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NetworkArea is the schema model
+type NetworkArea struct {
+	ID                  types.String      `tfsdk:"id"`
+	OrganizationID      types.String      `tfsdk:"organization_id"`
+	Name                types.String      `tfsdk:"name"`
+	NetworkRanges       []types.String    `tfsdk:"network_ranges"`
+	TransferNetwork     types.String      `tfsdk:"transfer_network"`
+	DefaultNameServers  types.Set         `tfsdk:"default_nameservers"`
+	DefaultPrefixLength types.Int64       `tfsdk:"default_prefix_length"`
+	MinPrefixLength     types.Int64       `tfsdk:"min_prefix_length"`
+	MaxPrefixLength     types.Int64       `tfsdk:"max_prefix_length"`
+	Routes              []Route           `tfsdk:"routes"`
+	Labels              map[string]string `tfsdk:"labels"`
+	Timeouts            timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// Route is a static route of the network area
+type Route struct {
+	Prefix  types.String `tfsdk:"prefix"`
+	NextHop types.String `tfsdk:"next_hop"`
+}
+
+// Schema returns terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	cidr := validate.StringWith(clientValidate.Prefix, "validate CIDR")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages STACKIT network areas. A network area connects the routed networks of the projects in an organization.\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the network area.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"network_ranges": schema.SetAttribute{
+				Description: "The IPv4 ranges in CIDR notation the networks of the area are created in. Ranges can be added and removed without recreating the network area.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(cidr),
+				},
+			},
+			"transfer_network": schema.StringAttribute{
+				Description: "The IPv4 transfer network in CIDR notation. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					cidr,
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_nameservers": schema.SetAttribute{
+				Description: "The default IPv4 nameservers of networks in the area.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validate.StringWith(clientValidate.IsIP, "validate IP")),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_prefix_length": schema.Int64Attribute{
+				Description: "The default prefix length of networks in the area. Default is `25`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(25),
+				Validators: []validator.Int64{
+					int64validator.Between(8, 29),
+				},
+			},
+			"min_prefix_length": schema.Int64Attribute{
+				Description: "The minimal prefix length of networks in the area. Default is `24`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(24),
+				Validators: []validator.Int64{
+					int64validator.Between(8, 29),
+				},
+			},
+			"max_prefix_length": schema.Int64Attribute{
+				Description: "The maximal prefix length of networks in the area. Default is `29`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(29),
+				Validators: []validator.Int64{
+					int64validator.Between(8, 29),
+				},
+			},
+			"routes": schema.SetNestedAttribute{
+				Description: "Static routes of the network area. Routes can be added and removed without recreating the network area.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"prefix": schema.StringAttribute{
+							Description: "The destination in CIDR notation.",
+							Required:    true,
+							Validators: []validator.String{
+								cidr,
+							},
+						},
+						"next_hop": schema.StringAttribute{
+							Description: "The IPv4 address of the next hop.",
+							Required:    true,
+							Validators: []validator.String{
+								validate.StringWith(clientValidate.IsIP, "validate IP"),
+							},
+						},
+					},
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Specifies labels of the network area.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"timeouts": common.Timeouts(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
@@ -14,7 +14,7 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/google/uuid"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -46,24 +46,7 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 }
 
 func (r Resource) createNetwork(ctx context.Context, resp *resource.CreateResponse, plan Network) Network {
-	var ns iaas_network.V1Nameserver
-
-	for _, i := range plan.NameServers.Elements() {
-		if i.IsNull() || i.IsUnknown() {
-			continue
-		}
-
-		nsVal, err := common.ToString(context.TODO(), i)
-		if err != nil {
-			continue
-		}
-
-		ns = appendIfMissting(ns, nsVal)
-	}
-
-	// ensure we have the Nameservers sorted
-	sort.Strings(ns)
-
+	ns := nameservers(ctx, plan.NameServers)
 	pl := int(plan.PrefixLengthV4.ValueInt64())
 	name := plan.Name.ValueString()
 
@@ -73,11 +56,26 @@ func (r Resource) createNetwork(ctx context.Context, resp *resource.CreateRespon
 			Ipv4: &iaas_network.V1CreateNetworkIPv4{
 				Nameservers:  &ns,
 				PrefixLength: &pl,
+				Gateway:      gateway(plan.GatewayV4, plan.NoGatewayV4),
+				NoGateway:    plan.NoGatewayV4.ValueBoolPointer(),
 			},
 		},
+		Routed: plan.Routed.ValueBoolPointer(),
+		Labels: common.FromLabels(plan.Labels),
+	}
+
+	if !plan.PrefixLengthV6.IsNull() {
+		nsV6 := nameservers(ctx, plan.NameServersV6)
+		plV6 := int(plan.PrefixLengthV6.ValueInt64())
+		body.AddressFamily.Ipv6 = &iaas_network.V1CreateNetworkIPv6{
+			Nameservers:  &nsV6,
+			PrefixLength: &plV6,
+			Gateway:      gateway(plan.GatewayV6, plan.NoGatewayV6),
+			NoGateway:    plan.NoGatewayV6.ValueBoolPointer(),
+		}
 	}
 
-	projectID, _ := uuid.Parse(plan.ProjectID.String())
+	projectID, _ := uuid.Parse(plan.ProjectID.ValueString())
 
 	res, err := r.client.IAAS.Network.V1CreateNetwork(ctx, projectID, body)
 	if err != nil {
@@ -103,20 +101,111 @@ func (r Resource) createNetwork(ctx context.Context, resp *resource.CreateRespon
 		return plan
 	}
 
-	prefixes := make([]attr.Value, 0)
+	plan.ProjectID = types.StringValue(projectID.String())
+	resp.Diagnostics.Append(transform(&plan, network)...)
+	return plan
+}
 
-	if network.Prefixes != nil && len(*network.Prefixes) > 0 {
-		for _, pr := range *network.Prefixes {
-			prefixes = append(prefixes, types.StringValue(pr))
+// nameservers returns the sorted, unique nameservers of the set
+func nameservers(ctx context.Context, set types.Set) []string {
+	ns := make([]string, 0)
+	for _, i := range set.Elements() {
+		if i.IsNull() || i.IsUnknown() {
+			continue
 		}
+
+		nsVal, err := common.ToString(ctx, i)
+		if err != nil {
+			continue
+		}
+
+		ns = appendIfMissting(ns, nsVal)
 	}
 
-	plan.ID = types.StringValue(network.NetworkID.String())
-	plan.PublicIp = types.StringPointerValue(network.PublicIp)
-	plan.Prefixes = types.ListValueMust(types.StringType, prefixes)
-	plan.ProjectID = types.StringValue(projectID.String())
+	// ensure we have the Nameservers sorted
+	sort.Strings(ns)
+	return ns
+}
 
-	return plan
+// gateway returns the configured gateway, or nil to let the API choose one
+// the gateway kept from state is dropped when the gateway is disabled
+func gateway(v types.String, noGateway types.Bool) *string {
+	if v.IsNull() || v.IsUnknown() || noGateway.ValueBool() {
+		return nil
+	}
+	return v.ValueStringPointer()
+}
+
+// prefixLength gets the prefix length of the first prefix in a hacky way
+func prefixLength(prefixes *[]string) (int64, bool, error) {
+	if prefixes == nil || len(*prefixes) == 0 {
+		return 0, false, nil
+	}
+
+	cidrSplit := strings.Split((*prefixes)[0], "/")
+	if len(cidrSplit) != 2 {
+		return 0, false, fmt.Errorf("invalid prefix %s", (*prefixes)[0])
+	}
+
+	l, err := strconv.ParseInt(cidrSplit[1], 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return l, true, nil
+}
+
+func stringList(in *[]string) []attr.Value {
+	out := make([]attr.Value, 0)
+	if in == nil {
+		return out
+	}
+
+	// ensure we have the values sorted
+	data := append([]string{}, *in...)
+	sort.Strings(data)
+	for _, v := range data {
+		out = append(out, types.StringValue(v))
+	}
+	return out
+}
+
+func transform(state *Network, n iaas_network.V1Network) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(n.NetworkID.String())
+	state.Name = types.StringValue(n.Name)
+	state.PublicIp = types.StringPointerValue(n.PublicIp)
+	state.Labels = common.ToLabels(n.Labels)
+	state.Routed = types.BoolValue(n.Routed != nil && *n.Routed)
+
+	// IPv4
+	state.Prefixes = types.ListValueMust(types.StringType, stringList(n.Prefixes))
+	state.NameServers = types.SetValueMust(types.StringType, stringList(n.Nameservers))
+	state.GatewayV4 = types.StringPointerValue(n.Gateway)
+	state.NoGatewayV4 = types.BoolValue(n.Gateway == nil)
+
+	// otherwise fall back to default
+	state.PrefixLengthV4 = types.Int64Value(25)
+	if l, ok, err := prefixLength(n.Prefixes); err != nil {
+		diags.AddError("Processing CIDR Prefix Length", err.Error())
+	} else if ok {
+		state.PrefixLengthV4 = types.Int64Value(l)
+	}
+
+	// IPv6
+	state.PrefixesV6 = types.ListValueMust(types.StringType, stringList(n.PrefixesV6))
+	state.NameServersV6 = types.SetValueMust(types.StringType, stringList(n.NameserversV6))
+	state.GatewayV6 = types.StringPointerValue(n.GatewayV6)
+	state.NoGatewayV6 = types.BoolValue(n.PrefixesV6 != nil && len(*n.PrefixesV6) > 0 && n.GatewayV6 == nil)
+
+	state.PrefixLengthV6 = types.Int64Null()
+	if l, ok, err := prefixLength(n.PrefixesV6); err != nil {
+		diags.AddError("Processing CIDR Prefix Length", err.Error())
+	} else if ok {
+		state.PrefixLengthV6 = types.Int64Value(l)
+	}
+
+	return diags
 }
 
 // Read - lifecycle function
@@ -137,60 +226,16 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
 		if validate.StatusEquals(res, http.StatusNotFound) {
 			resp.State.RemoveResource(ctx)
+			return
 		}
-		resp.Diagnostics.AddError("failed reading project", agg.Error())
+		resp.Diagnostics.AddError("failed reading network", agg.Error())
 		return
 	}
 
-	n := res.JSON200
-
-	prefixes := make([]attr.Value, 0)
-	if n.Prefixes != nil && len(*n.Prefixes) > 0 {
-		for _, pr := range *n.Prefixes {
-			prefixes = append(prefixes, types.StringValue(pr))
-		}
-	}
-
-	// ensure we have the nameservers sorted
-	nameservers := make([]attr.Value, 0)
-
-	if n.Nameservers != nil && len(*n.Nameservers) > 0 {
-		nsData := *n.Nameservers
-		sort.Strings(nsData)
-
-		for _, ns := range nsData {
-			nameservers = append(nameservers, types.StringValue(ns))
-		}
-	}
-
-	state.ID = types.StringValue(n.NetworkID.String())
 	state.ProjectID = types.StringValue(projectID.String())
-	state.Name = types.StringValue(n.Name)
-	state.PublicIp = types.StringPointerValue(n.PublicIp)
-	state.Prefixes = types.ListValueMust(types.StringType, prefixes)
-
-	state.NameServers = types.SetValueMust(types.StringType, nameservers)
-
-	// get the Prefix Length in a hacky way, otherwise fall back to default
-	if n.Prefixes != nil && len(*n.Prefixes) > 0 {
-		prefixData := *n.Prefixes
-
-		cidrSplit := strings.Split(prefixData[0], "/")
-		if len(cidrSplit) != 2 {
-			resp.Diagnostics.AddError("Processing CIDR Prefix Length",
-				"Processing CIDR Prefix Length")
-			return
-		}
-
-		prefixLength, err := strconv.ParseInt(cidrSplit[1], 10, 64)
-		if err != nil {
-			resp.Diagnostics.AddError("Processing CIDR Prefix Length", err.Error())
-			return
-		}
-
-		state.PrefixLengthV4 = types.Int64Value(prefixLength)
-	} else {
-		state.PrefixLengthV4 = types.Int64Value(25)
+	resp.Diagnostics.Append(transform(&state, *res.JSON200)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	diags = resp.State.Set(ctx, &state)
@@ -225,6 +270,20 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 		return
 	}
 
+	// read back the values computed by the API
+	projectID, _ := uuid.Parse(state.ProjectID.ValueString())
+	networkID, _ := uuid.Parse(state.ID.ValueString())
+	res, err := r.client.IAAS.Network.V1GetNetwork(ctx, projectID, networkID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		resp.Diagnostics.AddError("failed reading network", agg.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(transform(&plan, *res.JSON200)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// update state
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -234,27 +293,18 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 }
 
 func (r Resource) updateNetwork(ctx context.Context, plan, state Network, resp *resource.UpdateResponse) {
-	if plan.Name.Equal(state.Name) && reflect.DeepEqual(plan.NameServers, state.NameServers) {
+	if plan.Name.Equal(state.Name) &&
+		plan.NameServers.Equal(state.NameServers) &&
+		plan.NameServersV6.Equal(state.NameServersV6) &&
+		plan.GatewayV4.Equal(state.GatewayV4) &&
+		plan.NoGatewayV4.Equal(state.NoGatewayV4) &&
+		plan.GatewayV6.Equal(state.GatewayV6) &&
+		plan.NoGatewayV6.Equal(state.NoGatewayV6) &&
+		reflect.DeepEqual(plan.Labels, state.Labels) {
 		return
 	}
 
-	ns := make([]iaas_network.V1IP, 0)
-	for _, s := range plan.NameServers.Elements() {
-		if s.IsNull() || s.IsUnknown() {
-			continue
-		}
-
-		nsValue, err := common.ToString(ctx, s)
-		if err != nil {
-			continue
-		}
-
-		ns = appendIfMissting(ns, nsValue)
-	}
-
-	// ensure we have the Nameservers sorted
-	sort.Strings(ns)
-
+	ns := nameservers(ctx, plan.NameServers)
 	name := plan.Name.ValueString()
 
 	body := iaas_network.V1UpdateNetworkJSONBody{
@@ -262,8 +312,20 @@ func (r Resource) updateNetwork(ctx context.Context, plan, state Network, resp *
 		AddressFamily: &iaas_network.V1UpdateNetworkAddressFamily{
 			Ipv4: &iaas_network.V1UpdateNetworkIPv4{
 				Nameservers: &ns,
+				Gateway:     gateway(plan.GatewayV4, plan.NoGatewayV4),
+				NoGateway:   plan.NoGatewayV4.ValueBoolPointer(),
 			},
 		},
+		Labels: common.FromLabels(plan.Labels),
+	}
+
+	if !plan.PrefixLengthV6.IsNull() {
+		nsV6 := nameservers(ctx, plan.NameServersV6)
+		body.AddressFamily.Ipv6 = &iaas_network.V1UpdateNetworkIPv6{
+			Nameservers: &nsV6,
+			Gateway:     gateway(plan.GatewayV6, plan.NoGatewayV6),
+			NoGateway:   plan.NoGatewayV6.ValueBoolPointer(),
+		}
 	}
 
 	projectID, _ := uuid.Parse(state.ProjectID.ValueString())
@@ -271,7 +333,7 @@ func (r Resource) updateNetwork(ctx context.Context, plan, state Network, resp *
 
 	res, err := r.client.IAAS.Network.V1UpdateNetwork(ctx, projectID, networkID, iaas_network.V1UpdateNetworkJSONRequestBody(body))
 	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
-		resp.Diagnostics.AddError("failed updating project", agg.Error())
+		resp.Diagnostics.AddError("failed updating network", agg.Error())
 		return
 	}
 }
@@ -302,39 +364,17 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState handles terraform import
-func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,id` where `id` is the network_id and `project_id` is the project id.\nInstead got: %q", req.ID),
-		)
-		return
-	}
-
-	projectID := idParts[0]
-	networkID := idParts[1]
-
-	if err := clientValidate.ProjectID(projectID); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
-		return
-	}
-	if err := clientValidate.NetworkID(networkID); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate network_id.\n%s", err.Error()),
-		)
-		return
+// ImportParts returns the parts of the import identifier `project_id,id`
+func ImportParts() []common.ImportPart {
+	return []common.ImportPart{
+		common.ImportProjectID(),
+		{Name: "id", Attributes: []string{"id"}, Validate: clientValidate.NetworkID},
 	}
+}
 
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), networkID)...)
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, ImportParts()...)
 }
 
 func appendIfMissting(valueList []string, value string) []string {
@@ -39,6 +39,26 @@ func TestAcc_Network(t *testing.T) {
 					resource.TestCheckResourceAttr("stackit_network.example", "name", name),
 				),
 			},
+			// check in-place update of nameservers and labels
+			{
+				Config: configUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_network.example", "nameservers.#", "1"),
+					resource.TestCheckResourceAttr("stackit_network.example", "nameservers.0", "1.1.1.1"),
+					resource.TestCheckResourceAttr("stackit_network.example", "labels.env", "test"),
+					resource.TestCheckResourceAttr("stackit_network.example", "routed", "false"),
+				),
+			},
+			// check IPv6
+			{
+				Config: configV6(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_network.example", "prefix_length_v6", "64"),
+					resource.TestCheckResourceAttr("stackit_network.example", "nameservers_v6.#", "1"),
+					resource.TestCheckResourceAttr("stackit_network.example", "prefixes_v6.#", "1"),
+					resource.TestCheckResourceAttrSet("stackit_network.example", "gateway_v6"),
+				),
+			},
 			// test import
 			{
 				ResourceName: "stackit_network.example",
@@ -76,3 +96,37 @@ resource "stackit_network" "example" {
 		name,
 	)
 }
+
+func configUpdate(name string) string {
+	return fmt.Sprintf(`
+resource "stackit_network" "example" {
+	project_id  = "%s"
+	name        = "%s"
+	nameservers = ["1.1.1.1"]
+	labels = {
+		env = "test"
+	}
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+	)
+}
+
+func configV6(name string) string {
+	return fmt.Sprintf(`
+resource "stackit_network" "example" {
+	project_id       = "%s"
+	name             = "%s"
+	nameservers      = ["1.1.1.1"]
+	prefix_length_v6 = 64
+	nameservers_v6   = ["2001:4860:4860::8888"]
+	labels = {
+		env = "test"
+	}
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+	)
+}
@@ -4,13 +4,23 @@ import (
 	"context"
 	"fmt"
 	iaas_network "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/network"
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,20 +28,29 @@ import (
 
 // Network is the schema model
 type Network struct {
-	ID             types.String   `tfsdk:"id"`
-	Name           types.String   `tfsdk:"name"`
-	NameServers    types.Set      `tfsdk:"nameservers"`
-	Prefixes       types.List     `tfsdk:"prefixes"`
-	PrefixLengthV4 types.Int64    `tfsdk:"prefix_length_v4"`
-	PublicIp       types.String   `tfsdk:"public_ip"`
-	ProjectID      types.String   `tfsdk:"project_id"`
-	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	ID             types.String      `tfsdk:"id"`
+	Name           types.String      `tfsdk:"name"`
+	NameServers    types.Set         `tfsdk:"nameservers"`
+	Prefixes       types.List        `tfsdk:"prefixes"`
+	PrefixLengthV4 types.Int64       `tfsdk:"prefix_length_v4"`
+	GatewayV4      types.String      `tfsdk:"gateway_v4"`
+	NoGatewayV4    types.Bool        `tfsdk:"no_gateway_v4"`
+	NameServersV6  types.Set         `tfsdk:"nameservers_v6"`
+	PrefixesV6     types.List        `tfsdk:"prefixes_v6"`
+	PrefixLengthV6 types.Int64       `tfsdk:"prefix_length_v6"`
+	GatewayV6      types.String      `tfsdk:"gateway_v6"`
+	NoGatewayV6    types.Bool        `tfsdk:"no_gateway_v6"`
+	Routed         types.Bool        `tfsdk:"routed"`
+	PublicIp       types.String      `tfsdk:"public_ip"`
+	Labels         map[string]string `tfsdk:"labels"`
+	ProjectID      types.String      `tfsdk:"project_id"`
+	Timeouts       timeouts.Value    `tfsdk:"timeouts"`
 }
 
 // Schema returns terraform schema structure
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-
+		Version: 1,
 		MarkdownDescription: fmt.Sprintf("Manages STACKIT network\n%s",
 			common.EnvironmentInfo(r.urls),
 		),
@@ -51,17 +70,24 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				},
 			},
 			"nameservers": schema.SetAttribute{
-				Description: "List of DNS Servers/Nameservers.",
+				Description: "List of IPv4 DNS Servers/Nameservers. Can be changed without recreating the network.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"prefixes": schema.ListAttribute{
+				Description: "The IPv4 prefixes of the network.",
 				Computed:    true,
 				ElementType: types.StringType,
 				Validators: []validator.List{
 					validate.Prefixes(),
 				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"prefix_length_v4": schema.Int64Attribute{
 				Description: "prefix length",
@@ -71,6 +97,85 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Validators: []validator.Int64{
 					validate.PrefixLengthV4(),
 				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"gateway_v4": schema.StringAttribute{
+				Description: "The IPv4 gateway of the network. Defaults to the first IP of the network.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.StringWith(clientValidate.IsIP, "validate IP"),
+					stringvalidator.ConflictsWith(path.MatchRoot("no_gateway_v4")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"no_gateway_v4": schema.BoolAttribute{
+				Description: "If set to `true`, the network has no IPv4 gateway.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"nameservers_v6": schema.SetAttribute{
+				Description: "List of IPv6 DNS Servers/Nameservers. Can be changed without recreating the network.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.AlsoRequires(path.MatchRoot("prefix_length_v6")),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prefixes_v6": schema.ListAttribute{
+				Description: "The IPv6 prefixes of the network.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prefix_length_v6": schema.Int64Attribute{
+				Description: "IPv6 prefix length. If set, the network is created with IPv6 support. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(56, 64),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"gateway_v6": schema.StringAttribute{
+				Description: "The IPv6 gateway of the network. Defaults to the first IP of the network.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.StringWith(clientValidate.IsIP, "validate IP"),
+					stringvalidator.ConflictsWith(path.MatchRoot("no_gateway_v6")),
+					stringvalidator.AlsoRequires(path.MatchRoot("prefix_length_v6")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"no_gateway_v6": schema.BoolAttribute{
+				Description: "If set to `true`, the network has no IPv6 gateway.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"routed": schema.BoolAttribute{
+				Description: "If set to `true`, the network is routed and reachable from other networks in the same network area. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
 			},
 			"public_ip": schema.StringAttribute{
 				Description: "public IP address",
@@ -78,13 +183,24 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Validators: []validator.String{
 					validate.PublicIP(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Specifies labels of the network.",
+				Optional:    true,
+				ElementType: types.StringType,
 			},
 			"project_id": schema.StringAttribute{
-				Description: "The project UUID.",
+				Description: "The project UUID. Changing this value requires the resource to be recreated.",
 				Required:    true,
 				Validators: []validator.String{
 					validate.ProjectID(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"timeouts": common.Timeouts(ctx, timeouts.Opts{
 				Create: true,
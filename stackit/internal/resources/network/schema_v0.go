@@ -2,6 +2,7 @@ package network
 
 import (
 	"context"
+
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -9,9 +10,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// getSchemaV0 returns the schema before IPv6, gateways, labels and routed networks were added
 func getSchemaV0(ctx context.Context) *schema.Schema {
 	return &schema.Schema{
-		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Specifies the resource ID",
@@ -52,6 +53,7 @@ func getSchemaV0(ctx context.Context) *schema.Schema {
 	}
 }
 
+// upgradeV0 keeps the existing values and sets the attributes added in version 1 to the values of networks created without them
 func upgradeV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
 	type NetworkV0 struct {
 		ID             types.String   `tfsdk:"id"`
@@ -82,11 +84,19 @@ func upgradeV0(ctx context.Context, req resource.UpgradeStateRequest, resp *reso
 		ID:             oldState.ID,
 		Name:           oldState.Name,
 		NameServers:    ns,
-		Prefixes:       types.List{},
-		PrefixLengthV4: types.Int64{},
-		PublicIp:       types.String{},
-		ProjectID:      types.String{},
-		Timeouts:       timeouts.Value{},
+		Prefixes:       oldState.Prefixes,
+		PrefixLengthV4: oldState.PrefixLengthV4,
+		GatewayV4:      types.StringNull(),
+		NoGatewayV4:    types.BoolValue(false),
+		NameServersV6:  types.SetNull(types.StringType),
+		PrefixesV6:     types.ListNull(types.StringType),
+		PrefixLengthV6: types.Int64Null(),
+		GatewayV6:      types.StringNull(),
+		NoGatewayV6:    types.BoolValue(false),
+		Routed:         types.BoolValue(false),
+		PublicIp:       oldState.PublicIp,
+		ProjectID:      oldState.ProjectID,
+		Timeouts:       oldState.Timeouts,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
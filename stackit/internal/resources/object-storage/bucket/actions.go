@@ -2,17 +2,14 @@ package bucket
 
 import (
 	"context"
-	"fmt"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1/bucket"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/enablement"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -60,6 +57,7 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		Region:                 types.StringValue(b.Bucket.Region),
 		HostStyleURL:           types.StringValue(b.Bucket.UrlVirtualHostedStyle),
 		PathStyleURL:           types.StringValue(b.Bucket.UrlPathStyle),
+		DeletionProtection:     bucket.DeletionProtection,
 		Timeouts:               bucket.Timeouts,
 	})
 
@@ -85,21 +83,8 @@ func (r Resource) preProcessConfig(diags *diag.Diagnostics, b *Bucket) {
 }
 
 func (r Resource) enableProject(ctx context.Context, diags *diag.Diagnostics, b *Bucket) {
-	projectID := b.ProjectID.ValueString()
-	c := r.client.ObjectStorage.Project
-
-	status, err := c.Get(ctx, projectID)
-	if agg := common.Validate(&diag.Diagnostics{}, status, err, "JSON200"); agg != nil {
-		if status == nil || status.StatusCode() != http.StatusNotFound {
-			diags.AddError("failed to fetch Object Storage project status", agg.Error())
-			return
-		}
-	}
-
-	res, err := c.Create(ctx, projectID)
-	if agg := common.Validate(diags, res, err); agg != nil {
-		diags.AddError("failed during Object Storage project init", agg.Error())
-		return
+	if err := enablement.For(r.client).EnableObjectStorage(ctx, b.ProjectID.ValueString()); err != nil {
+		diags.AddError("failed during Object Storage project init", err.Error())
 	}
 }
 
@@ -139,6 +124,8 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
+	common.ReadDeletionProtection(&state.DeletionProtection)
+
 	// pre-process config
 	r.preProcessConfig(&resp.Diagnostics, &state)
 	if resp.Diagnostics.HasError() {
@@ -180,6 +167,10 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		return
 	}
 
+	if common.DeletionProtected(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+
 	// pre-process config
 	r.preProcessConfig(&resp.Diagnostics, &state)
 	if resp.Diagnostics.HasError() {
@@ -212,31 +203,15 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState handles terraform import
-func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,name` where `name` is the cluster name.\nInstead got: %q", req.ID),
-		)
-		return
+// ImportParts returns the parts of the import identifier `project_id,name`
+func ImportParts() []common.ImportPart {
+	return []common.ImportPart{
+		common.ImportProjectID("project_id", "object_storage_project_id"),
+		common.ImportString("name", "id", "name"),
 	}
+}
 
-	// validate project id
-	if err := clientValidate.ProjectID(idParts[0]); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
-		return
-	}
-
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_storage_project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
-
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, ImportParts()...)
 }
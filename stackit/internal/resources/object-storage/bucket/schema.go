@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -24,6 +23,7 @@ type Bucket struct {
 	Region                 types.String   `tfsdk:"region"`
 	HostStyleURL           types.String   `tfsdk:"host_style_url"`
 	PathStyleURL           types.String   `tfsdk:"path_style_url"`
+	DeletionProtection     types.Bool     `tfsdk:"deletion_protection"`
 	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
@@ -43,7 +43,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Description: "Bucket name",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					common.ReplaceUnlessProtected{},
 				},
 			},
 
@@ -56,7 +56,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					validate.ProjectID(),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					common.ReplaceUnlessProtected{},
 				},
 			},
 
@@ -68,7 +68,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					validate.ProjectID(),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					common.ReplaceUnlessProtected{},
 				},
 			},
 
@@ -93,6 +93,8 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Optional:    false,
 			},
 
+			"deletion_protection": common.DeletionProtection("bucket"),
+
 			"timeouts": common.Timeouts(ctx, timeouts.Opts{
 				Create: true,
 				Delete: true,
@@ -2,11 +2,12 @@ package credential
 
 import (
 	"context"
-	"net/http"
+	"strings"
 	"time"
 
 	accesskey "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1/access-key"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/enablement"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -73,21 +74,8 @@ func (r Resource) preProcessConfig(diags *diag.Diagnostics, b *Credential) {
 }
 
 func (r Resource) enableProject(ctx context.Context, diags *diag.Diagnostics, b *Credential) {
-	projectID := b.ProjectID.ValueString()
-	c := r.client.ObjectStorage.Project
-
-	status, err := c.Get(ctx, projectID)
-	if agg := common.Validate(&diag.Diagnostics{}, status, err, "JSON200"); agg != nil {
-		if status == nil || status.StatusCode() != http.StatusNotFound {
-			diags.AddError("failed to fetch Object Storage project status", agg.Error())
-			return
-		}
-	}
-
-	res, err := c.Create(ctx, projectID)
-	if agg := common.Validate(diags, res, err); agg != nil {
-		diags.AddError("failed during Object Storage project init", agg.Error())
-		return
+	if err := enablement.For(r.client).EnableObjectStorage(ctx, b.ProjectID.ValueString()); err != nil {
+		diags.AddError("failed during Object Storage project init", err.Error())
 	}
 }
 
@@ -206,3 +194,18 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 	resp.State.RemoveResource(ctx)
 }
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := []common.ImportPart{
+		common.ImportProjectID("project_id", "object_storage_project_id"),
+		common.ImportString("credentials_group_id"),
+		common.ImportString("id"),
+	}
+
+	// credentials of the default group can be imported without a group ID
+	if strings.Count(req.ID, ",") == 1 {
+		parts = []common.ImportPart{parts[0], parts[2]}
+	}
+	common.ImportState(ctx, req, resp, parts...)
+}
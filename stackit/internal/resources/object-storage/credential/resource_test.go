@@ -1,6 +1,7 @@
 package credential_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 const run_this_test = false
@@ -46,6 +48,29 @@ func TestAcc_ObjectStorageCredential(t *testing.T) {
 					resource.TestCheckResourceAttrSet("stackit_object_storage_credential.example", "secret_access_key"),
 				),
 			},
+			// test import
+			{
+				ResourceName: "stackit_object_storage_credential.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_object_storage_credential.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_object_storage_credential.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+					group, ok := r.Primary.Attributes["credentials_group_id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute credentials_group_id")
+					}
+
+					return fmt.Sprintf("%s,%s,%s", common.GetAcceptanceTestsProjectID(), group, id), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key", "secret_access_key"},
+			},
 		},
 	})
 }
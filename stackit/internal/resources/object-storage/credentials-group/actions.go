@@ -2,15 +2,11 @@ package credentialsgroup
 
 import (
 	"context"
-	"fmt"
-	"net/http"
-	"strings"
 
 	credentialsgroup "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1/credentials-group"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/enablement"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -92,21 +88,8 @@ func (r Resource) preProcessConfig(diags *diag.Diagnostics, b *CredentialsGroup)
 }
 
 func (r Resource) enableProject(ctx context.Context, diags *diag.Diagnostics, b *CredentialsGroup) {
-	projectID := b.ProjectID.ValueString()
-	c := r.client.ObjectStorage.Project
-
-	status, err := c.Get(ctx, projectID)
-	if agg := common.Validate(&diag.Diagnostics{}, status, err, "JSON200"); agg != nil {
-		if status == nil || status.StatusCode() != http.StatusNotFound {
-			diags.AddError("failed to fetch Object Storage project status", agg.Error())
-			return
-		}
-	}
-
-	res, err := c.Create(ctx, projectID)
-	if agg := common.Validate(diags, res, err); agg != nil {
-		diags.AddError("failed during Object Storage project init", agg.Error())
-		return
+	if err := enablement.For(r.client).EnableObjectStorage(ctx, b.ProjectID.ValueString()); err != nil {
+		diags.AddError("failed during Object Storage project init", err.Error())
 	}
 }
 
@@ -184,27 +167,5 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 // ImportState handles terraform import
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,id` where `name` is the credentials group name.\nInstead got: %q", req.ID),
-		)
-		return
-	}
-
-	// validate project id
-	if err := clientValidate.ProjectID(idParts[0]); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
-		return
-	}
-
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_storage_project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
+	common.ImportState(ctx, req, resp, common.ImportProjectID("project_id", "object_storage_project_id"), common.ImportString("name"))
 }
@@ -9,7 +9,6 @@ import (
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/instance"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -30,7 +29,7 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	plan.setDefaults()
 
 	// validate
-	if err := r.validate(ctx, &resp.Diagnostics, plan); err != nil {
+	if err := r.validate(ctx, plan); err != nil {
 		resp.Diagnostics.AddError("failed postgres validation", err.Error())
 		return
 	}
@@ -69,6 +68,10 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	sc := storage.Class.ValueString()
 	ss := int(storage.Size.ValueInt64())
 	v := plan.Version.ValueString()
+	opts, d := plan.toOptions(ctx)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
 
 	body := instance.InstanceCreateInstanceRequest{
 		Name: &name,
@@ -78,7 +81,7 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		BackupSchedule: &bu,
 		FlavorID:       &flavorID,
 		Labels:         &plan.Labels,
-		Options:        &plan.Options,
+		Options:        &opts,
 		Replicas:       &repl,
 		Storage: &instance.InstanceStorage{
 			Class: &sc,
@@ -174,6 +177,8 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
+	common.ReadDeletionProtection(&state.DeletionProtection)
+
 	// read instance
 	c := r.client.PostgresFlex
 	res, err := c.Instance.Get(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
@@ -211,7 +216,7 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 	plan.ID = state.ID
 
 	// validate
-	if err := r.validate(ctx, &resp.Diagnostics, plan); err != nil {
+	if err := r.validate(ctx, plan); err != nil {
 		resp.Diagnostics.AddError("failed postgres validation", err.Error())
 		return
 	}
@@ -246,6 +251,11 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 	sc := storage.Class.ValueString()
 	ss := int(storage.Size.ValueInt64())
 	v := plan.Version.ValueString()
+	opts, d := plan.toOptions(ctx)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+	resetRemovedParameters(opts, state.Parameters)
 
 	body := instance.InstanceUpdateInstanceRequest{
 		Name: &name,
@@ -255,7 +265,7 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 		BackupSchedule: &bu,
 		FlavorID:       &flavorID,
 		Labels:         &plan.Labels,
-		Options:        &plan.Options,
+		Options:        &opts,
 		Replicas:       &repl,
 		Storage: &instance.InstanceStorage{
 			Class: &sc,
@@ -317,6 +327,10 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		return
 	}
 
+	if common.DeletionProtected(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+
 	// init client
 	c := r.client.PostgresFlex
 	res, err := c.Instance.Delete(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
@@ -350,32 +364,28 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState handles terraform import
-func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,postgres_instance_id`.\nInstead got: %q", req.ID),
-		)
-		return
+// ImportParts returns the parts of the import identifier `project_id,postgres_instance_id|name`
+func ImportParts() []common.ImportPart {
+	return []common.ImportPart{
+		common.ImportProjectID(),
+		common.ImportIDOrName("postgres_instance_id"),
 	}
+}
 
-	// validate project id
-	if err := clientValidate.ProjectID(idParts[0]); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, ok := common.ImportState(ctx, req, resp, ImportParts()...)
+	if !ok {
 		return
 	}
 
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
-
-	if resp.Diagnostics.HasError() {
-		return
+	projectID, instanceID := id.Get("project_id"), id.Get("postgres_instance_id")
+	if id.IsName("postgres_instance_id") {
+		var err error
+		if instanceID, err = r.instanceIDByName(ctx, &resp.Diagnostics, projectID, instanceID); err != nil {
+			resp.Diagnostics.AddError("failed to find instance by name", err.Error())
+			return
+		}
 	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), instanceID)...)
 }
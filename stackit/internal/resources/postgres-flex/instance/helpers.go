@@ -6,11 +6,16 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/flavors"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/instance"
+	storageoptions "github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/storage"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/versions"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -38,11 +43,11 @@ func (i *Instance) setDefaults() {
 	}
 }
 
-func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data Instance) error {
-	if err := r.validateVersion(ctx, diags, data.ProjectID.ValueString(), data.Version.ValueString()); err != nil {
+func (r Resource) validate(ctx context.Context, data Instance) error {
+	if err := r.validateVersion(ctx, data.ProjectID.ValueString(), data.Version.ValueString()); err != nil {
 		return err
 	}
-	if err := r.validateMachineType(ctx, diags, data.ProjectID.ValueString(), data.MachineType.ValueString()); err != nil {
+	if err := r.validateMachineType(ctx, data.ProjectID.ValueString(), data.MachineType.ValueString()); err != nil {
 		return err
 	}
 
@@ -56,20 +61,58 @@ func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data In
 		return errors.New("failed setting storage from object")
 	}
 
-	if err := r.validateStorage(ctx, diags, data.ProjectID.ValueString(), data.MachineType.ValueString(), storage); err != nil {
+	if err := r.validateStorage(ctx, data.ProjectID.ValueString(), data.MachineType.ValueString(), storage); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (r Resource) validateVersion(ctx context.Context, diags *diag.Diagnostics, projectID, version string) error {
-	res, err := r.client.PostgresFlex.Versions.List(ctx, projectID, &versions.ListParams{})
-	if agg := common.Validate(diags, res, err, "JSON200.Versions"); agg != nil {
-		return agg
+// validatePlan validates the machine type and storage at plan time
+// values that aren't known yet are validated on apply
+func (r Resource) validatePlan(ctx context.Context, diags *diag.Diagnostics, plan Instance) {
+	if r.client == nil || plan.ProjectID.IsUnknown() || plan.MachineType.IsUnknown() {
+		return
+	}
+	if err := r.validateMachineType(ctx, plan.ProjectID.ValueString(), plan.MachineType.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("machine_type"), "invalid machine type", err.Error())
+		return
+	}
+
+	if plan.Storage.IsNull() || plan.Storage.IsUnknown() {
+		return
+	}
+	storage := Storage{}
+	if d := plan.Storage.As(ctx, &storage, basetypes.ObjectAsOptions{}); d.HasError() {
+		diags.Append(d...)
+		return
+	}
+	if storage.Class.IsUnknown() || storage.Size.IsUnknown() {
+		return
+	}
+	if err := r.validateStorage(ctx, plan.ProjectID.ValueString(), plan.MachineType.ValueString(), storage); err != nil {
+		diags.AddAttributeError(path.Root("storage"), "invalid storage", err.Error())
+	}
+}
+
+func (r Resource) listVersions(ctx context.Context, projectID string) ([]string, error) {
+	res, err := cache.Load(ctx, r.client.Cache, cache.Key("postgres-flex/versions", projectID), func(ctx context.Context) (*versions.ListResponse, error) {
+		res, err := r.client.PostgresFlex.Versions.List(ctx, projectID, &versions.ListParams{})
+		return res, validate.Response(res, err, "JSON200.Versions")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return *res.JSON200.Versions, nil
+}
+
+func (r Resource) validateVersion(ctx context.Context, projectID, version string) error {
+	list, err := r.listVersions(ctx, projectID)
+	if err != nil {
+		return err
 	}
 
 	opts := ""
-	for _, v := range *res.JSON200.Versions {
+	for _, v := range list {
 		opts = opts + "\n- " + v
 		if strings.EqualFold(v, version) {
 			return nil
@@ -78,10 +121,13 @@ func (r Resource) validateVersion(ctx context.Context, diags *diag.Diagnostics,
 	return fmt.Errorf("couldn't find version '%s'. Available options are:%s\n", version, opts)
 }
 
-func (r Resource) validateMachineType(ctx context.Context, diags *diag.Diagnostics, projectID, flavorID string) error {
-	res, err := r.client.PostgresFlex.Flavors.List(ctx, projectID)
-	if agg := common.Validate(diags, res, err, "JSON200.Flavors"); agg != nil {
-		return agg
+func (r Resource) validateMachineType(ctx context.Context, projectID, flavorID string) error {
+	res, err := cache.Load(ctx, r.client.Cache, cache.Key("postgres-flex/flavors", projectID), func(ctx context.Context) (*flavors.ListResponse, error) {
+		res, err := r.client.PostgresFlex.Flavors.List(ctx, projectID)
+		return res, validate.Response(res, err, "JSON200.Flavors")
+	})
+	if err != nil {
+		return err
 	}
 
 	opts := ""
@@ -97,10 +143,13 @@ func (r Resource) validateMachineType(ctx context.Context, diags *diag.Diagnosti
 	return fmt.Errorf("couldn't find machine type '%s'. Available options are:%s\n", flavorID, opts)
 }
 
-func (r Resource) validateStorage(ctx context.Context, diags *diag.Diagnostics, projectID, machineType string, storage Storage) error {
-	res, err := r.client.PostgresFlex.Storage.GetStorageOptions(ctx, projectID, machineType)
-	if agg := common.Validate(diags, res, err, "JSON200.StorageClasses"); agg != nil {
-		return agg
+func (r Resource) validateStorage(ctx context.Context, projectID, machineType string, storage Storage) error {
+	res, err := cache.Load(ctx, r.client.Cache, cache.Key("postgres-flex/storages", projectID, machineType), func(ctx context.Context) (*storageoptions.GetStorageOptionsResponse, error) {
+		res, err := r.client.PostgresFlex.Storage.GetStorageOptions(ctx, projectID, machineType)
+		return res, validate.Response(res, err, "JSON200.StorageClasses")
+	})
+	if err != nil {
+		return err
 	}
 
 	size := storage.Size.ValueInt64()
@@ -117,7 +166,7 @@ func (r Resource) validateStorage(ctx context.Context, diags *diag.Diagnostics,
 			return nil
 		}
 	}
-	return fmt.Errorf("couldn't find version '%s'. Available options are:%s\n", storage.Class.ValueString(), opts)
+	return fmt.Errorf("couldn't find storage class '%s'. Available options are:%s\n", storage.Class.ValueString(), opts)
 }
 
 func applyClientResponse(pi *Instance, i *instance.InstanceSingleInstance) error {
@@ -176,5 +225,26 @@ func applyClientResponse(pi *Instance, i *instance.InstanceSingleInstance) error
 	if i.Version != nil {
 		pi.Version = types.StringValue(*i.Version)
 	}
+	opts := map[string]string{}
+	if i.Options != nil {
+		opts = *i.Options
+	}
+	pi.applyOptions(opts)
 	return nil
 }
+
+// instanceIDByName returns the ID of the instance called name
+func (r Resource) instanceIDByName(ctx context.Context, diags *diag.Diagnostics, projectID, name string) (string, error) {
+	res, err := r.client.PostgresFlex.Instance.List(ctx, projectID)
+	if agg := common.Validate(diags, res, err, "JSON200.Items"); agg != nil {
+		return "", agg
+	}
+
+	items := *res.JSON200.Items
+	return common.IDByName("instance", name, len(items), func(i int) (string, string) {
+		if items[i].Name == nil || items[i].ID == nil {
+			return "", ""
+		}
+		return *items[i].Name, *items[i].ID
+	})
+}
@@ -0,0 +1,301 @@
+package postgresinstance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// extensionsOptionKey is the instance option used by the API to hold the enabled extensions
+const extensionsOptionKey = "extensions"
+
+type parameterKind int
+
+const (
+	parameterInt parameterKind = iota
+	parameterBool
+	parameterEnum
+	parameterString
+)
+
+// parameterSpec describes a server parameter that can be set on an instance
+// def is the postgres default, which is sent when the parameter is removed from the configuration
+type parameterSpec struct {
+	kind       parameterKind
+	min, max   int64
+	values     []string
+	minVersion int
+	def        string
+}
+
+// supportedParameters lists the server parameters that can be configured
+// values for memory settings are in kB and for timeouts in milliseconds
+var supportedParameters = map[string]parameterSpec{
+	"default_transaction_isolation":       {kind: parameterEnum, values: []string{"serializable", "repeatable read", "read committed", "read uncommitted"}, def: "read committed"},
+	"idle_in_transaction_session_timeout": {kind: parameterInt, min: 0, max: 2147483647, def: "0"},
+	"idle_session_timeout":                {kind: parameterInt, min: 0, max: 2147483647, minVersion: 14, def: "0"},
+	"lock_timeout":                        {kind: parameterInt, min: 0, max: 2147483647, def: "0"},
+	"log_connections":                     {kind: parameterBool, def: "off"},
+	"log_disconnections":                  {kind: parameterBool, def: "off"},
+	"log_min_duration_statement":          {kind: parameterInt, min: -1, max: 2147483647, def: "-1"},
+	"log_statement":                       {kind: parameterEnum, values: []string{"none", "ddl", "mod", "all"}, def: "none"},
+	"maintenance_work_mem":                {kind: parameterInt, min: 1024, max: 2147483647, def: "65536"},
+	"max_connections":                     {kind: parameterInt, min: 25, max: 5000, def: "100"},
+	"statement_timeout":                   {kind: parameterInt, min: 0, max: 2147483647, def: "0"},
+	"temp_file_limit":                     {kind: parameterInt, min: -1, max: 2147483647, def: "-1"},
+	"timezone":                            {kind: parameterString, def: "UTC"},
+	"track_io_timing":                     {kind: parameterBool, def: "off"},
+	"work_mem":                            {kind: parameterInt, min: 64, max: 2147483647, def: "4096"},
+}
+
+// supportedExtensions lists the extensions that can be enabled and the minimal major version
+var supportedExtensions = map[string]int{
+	"btree_gin":          12,
+	"btree_gist":         12,
+	"citext":             12,
+	"fuzzystrmatch":      12,
+	"hstore":             12,
+	"intarray":           12,
+	"ltree":              12,
+	"pg_stat_statements": 12,
+	"pg_trgm":            12,
+	"pgcrypto":           12,
+	"postgis":            12,
+	"tablefunc":          12,
+	"unaccent":           12,
+	"uuid-ossp":          12,
+}
+
+// majorVersion returns the major part of a postgres version string
+func majorVersion(version string) (int, error) {
+	v, err := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+	if err != nil {
+		return 0, fmt.Errorf("couldn't parse postgres version '%s'", version)
+	}
+	return v, nil
+}
+
+func validateParameter(name, value string, version int) error {
+	spec, ok := supportedParameters[name]
+	if !ok || spec.minVersion > version {
+		return fmt.Errorf("parameter '%s' is not supported for version %d. Supported parameters are:%s", name, version, listOptions(supportedParameterNames(version)))
+	}
+
+	switch spec.kind {
+	case parameterInt:
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("parameter '%s' expects an integer, got '%s'", name, value)
+		}
+		if v < spec.min || v > spec.max {
+			return fmt.Errorf("parameter '%s' value %d is not in the allowed range: %d..%d", name, v, spec.min, spec.max)
+		}
+	case parameterBool:
+		if value != "on" && value != "off" && value != "true" && value != "false" {
+			return fmt.Errorf("parameter '%s' expects one of `on`, `off`, `true`, `false`, got '%s'", name, value)
+		}
+	case parameterEnum:
+		// values are matched exactly, the API returns them in lower case
+		for _, v := range spec.values {
+			if v == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("parameter '%s' value '%s' is not supported. Available options are:%s", name, value, listOptions(spec.values))
+	case parameterString:
+		if value == "" {
+			return fmt.Errorf("parameter '%s' can't be empty", name)
+		}
+	}
+	return nil
+}
+
+func validateExtension(name string, version int) error {
+	if minVersion, ok := supportedExtensions[name]; ok && minVersion <= version {
+		return nil
+	}
+	return fmt.Errorf("extension '%s' is not supported for version %d. Supported extensions are:%s", name, version, listOptions(supportedExtensionNames(version)))
+}
+
+func supportedParameterNames(version int) []string {
+	names := []string{}
+	for k, v := range supportedParameters {
+		if v.minVersion <= version {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func supportedExtensionNames(version int) []string {
+	names := []string{}
+	for k, v := range supportedExtensions {
+		if v <= version {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func listOptions(opts []string) string {
+	s := ""
+	for _, v := range opts {
+		s = s + "\n- " + v
+	}
+	return s
+}
+
+// ValidateConfig validates parameters and extensions against the configured version
+func (r Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var (
+		v          types.String
+		options    types.Map
+		parameters types.Map
+		extensions types.Set
+	)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("version"), &v)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("options"), &options)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("parameters"), &parameters)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("extensions"), &extensions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the deprecated options are sent together with parameters and extensions, so their keys can't overlap
+	opts := options.Elements()
+	for k := range parameters.Elements() {
+		if _, ok := opts[k]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("parameters").AtMapKey(k), "parameter set twice",
+				fmt.Sprintf("'%s' is also set in the deprecated `options`, remove it from `options`", k))
+		}
+	}
+	if _, ok := opts[extensionsOptionKey]; ok && !extensions.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("extensions"), "extensions set twice",
+			"extensions are also set in the deprecated `options`, remove `extensions` from `options`")
+	}
+	if resp.Diagnostics.HasError() || v.IsUnknown() {
+		return
+	}
+
+	raw := DefaultVersion
+	if !v.IsNull() {
+		raw = v.ValueString()
+	}
+	version, err := majorVersion(raw)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("version"), "invalid version", err.Error())
+		return
+	}
+
+	if !parameters.IsNull() && !parameters.IsUnknown() {
+		for k, val := range parameters.Elements() {
+			if val.IsUnknown() || val.IsNull() {
+				continue
+			}
+			if err := validateParameter(k, val.(types.String).ValueString(), version); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("parameters").AtMapKey(k), "unsupported parameter", err.Error())
+			}
+		}
+	}
+
+	if extensions.IsNull() || extensions.IsUnknown() {
+		return
+	}
+	for _, e := range extensions.Elements() {
+		if e.IsUnknown() || e.IsNull() {
+			continue
+		}
+		name := e.(types.String).ValueString()
+		if err := validateExtension(name, version); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("extensions"), "unsupported extension", err.Error())
+		}
+	}
+}
+
+// toOptions merges the legacy options with the typed parameters and extensions
+func (i *Instance) toOptions(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	opts := map[string]string{}
+	for k, v := range i.Options {
+		opts[k] = v
+	}
+	for k, v := range i.Parameters {
+		opts[k] = v
+	}
+
+	if i.Extensions.IsNull() || i.Extensions.IsUnknown() {
+		return opts, nil
+	}
+	ext := []string{}
+	if diags := i.Extensions.ElementsAs(ctx, &ext, false); diags.HasError() {
+		return nil, diags
+	}
+	sort.Strings(ext)
+	opts[extensionsOptionKey] = strings.Join(ext, ",")
+	return opts, nil
+}
+
+// resetRemovedParameters sets the parameters of prior that are missing in opts to their defaults
+// the API keeps options that aren't sent, so removing a parameter from the configuration wouldn't reset it
+func resetRemovedParameters(opts, prior map[string]string) {
+	for k := range prior {
+		spec, ok := supportedParameters[k]
+		if _, set := opts[k]; set || !ok {
+			continue
+		}
+		opts[k] = spec.def
+	}
+}
+
+// SplitOptions splits instance options into server parameters and enabled extensions
+func SplitOptions(opts map[string]string) (map[string]string, []string) {
+	params := map[string]string{}
+	extensions := []string{}
+	for k, v := range opts {
+		if k != extensionsOptionKey {
+			params[k] = v
+			continue
+		}
+		for _, e := range strings.Split(v, ",") {
+			if e = strings.TrimSpace(e); e != "" {
+				extensions = append(extensions, e)
+			}
+		}
+	}
+	sort.Strings(extensions)
+	return params, extensions
+}
+
+// applyOptions reads parameters and extensions from the instance options
+// only parameters present in the plan or state are read back, extensions only if they're configured
+func (i *Instance) applyOptions(opts map[string]string) {
+	remote, extensions := SplitOptions(opts)
+	params := map[string]string{}
+	for k := range i.Parameters {
+		if v, ok := remote[k]; ok {
+			params[k] = v
+		}
+	}
+	i.Parameters = nil
+	if len(params) > 0 {
+		i.Parameters = params
+	}
+
+	if i.Extensions.IsNull() {
+		return
+	}
+	elems := []attr.Value{}
+	for _, e := range extensions {
+		elems = append(elems, types.StringValue(e))
+	}
+	i.Extensions = types.SetValueMust(types.StringType, elems)
+}
@@ -0,0 +1,135 @@
+package postgresinstance
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_validateParameter(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+	tests := []struct {
+		name    string
+		param   string
+		value   string
+		version int
+		wantErr bool
+	}{
+		{name: "valid int", param: "max_connections", value: "100", version: 14},
+		{name: "int out of range", param: "max_connections", value: "10", version: 14, wantErr: true},
+		{name: "int not a number", param: "work_mem", value: "4MB", version: 14, wantErr: true},
+		{name: "valid bool", param: "log_connections", value: "on", version: 13},
+		{name: "invalid bool", param: "log_connections", value: "yes", version: 13, wantErr: true},
+		{name: "valid enum", param: "log_statement", value: "ddl", version: 12},
+		{name: "invalid enum", param: "log_statement", value: "some", version: 12, wantErr: true},
+		{name: "enum with different case", param: "log_statement", value: "DDL", version: 12, wantErr: true},
+		{name: "bool with different case", param: "log_connections", value: "On", version: 13, wantErr: true},
+		{name: "unknown parameter", param: "shared_buffers", value: "128", version: 14, wantErr: true},
+		{name: "parameter requires newer version", param: "idle_session_timeout", value: "1000", version: 13, wantErr: true},
+		{name: "parameter with supported version", param: "idle_session_timeout", value: "1000", version: 14},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateParameter(tt.param, tt.value, tt.version); (err != nil) != tt.wantErr {
+				t.Errorf("validateParameter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateExtension(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+	if err := validateExtension("pg_stat_statements", 14); err != nil {
+		t.Errorf("validateExtension() unexpected error = %v", err)
+	}
+	if err := validateExtension("timescaledb", 14); err == nil {
+		t.Error("validateExtension() expected an error for an unsupported extension")
+	}
+}
+
+func Test_optionsRoundTrip(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+	i := Instance{
+		Parameters: map[string]string{"max_connections": "100"},
+		Extensions: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("postgis")}),
+	}
+	i.applyOptions(map[string]string{
+		"max_connections": "100",
+		"work_mem":        "4096",
+		"extensions":      "postgis,pg_stat_statements",
+	})
+	if len(i.Parameters) != 1 || i.Parameters["max_connections"] != "100" {
+		t.Errorf("applyOptions() unexpected parameters %v", i.Parameters)
+	}
+	if len(i.Extensions.Elements()) != 2 {
+		t.Errorf("applyOptions() unexpected extensions %v", i.Extensions)
+	}
+
+	untracked := Instance{Extensions: types.SetNull(types.StringType)}
+	untracked.applyOptions(map[string]string{
+		"work_mem":   "4096",
+		"extensions": "postgis",
+	})
+	if untracked.Parameters != nil || !untracked.Extensions.IsNull() {
+		t.Errorf("applyOptions() tracked unconfigured values %v %v", untracked.Parameters, untracked.Extensions)
+	}
+}
+
+func Test_toOptionsExtensions(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+	i := Instance{
+		Options:    map[string]string{"timezone": "UTC"},
+		Parameters: map[string]string{"max_connections": "100"},
+		Extensions: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("postgis"), types.StringValue("pg_stat_statements")}),
+	}
+	opts, diags := i.toOptions(context.Background())
+	if diags.HasError() {
+		t.Fatalf("toOptions() unexpected errors %v", diags)
+	}
+	want := map[string]string{"timezone": "UTC", "max_connections": "100", "extensions": "pg_stat_statements,postgis"}
+	if !reflect.DeepEqual(opts, want) {
+		t.Errorf("toOptions() = %v, want %v", opts, want)
+	}
+
+	params, extensions := SplitOptions(opts)
+	if !reflect.DeepEqual(extensions, []string{"pg_stat_statements", "postgis"}) {
+		t.Errorf("SplitOptions() extensions = %v", extensions)
+	}
+	if _, ok := params["extensions"]; ok || params["max_connections"] != "100" {
+		t.Errorf("SplitOptions() parameters = %v", params)
+	}
+}
+
+func Test_resetRemovedParameters(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+	opts := map[string]string{"work_mem": "8192"}
+	resetRemovedParameters(opts, map[string]string{"work_mem": "4096", "log_statement": "all", "custom": "x"})
+	want := map[string]string{"work_mem": "8192", "log_statement": "none"}
+	if !reflect.DeepEqual(opts, want) {
+		t.Errorf("resetRemovedParameters() = %v, want %v", opts, want)
+	}
+}
@@ -2,11 +2,14 @@ package postgresinstance
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
-	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
 	postgresflex "github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -19,7 +22,7 @@ func New() resource.Resource {
 
 // Resource is the exported resource
 type Resource struct {
-	client *services.Services
+	client *providerdata.Client
 	urls   baseurl.BaseURL
 }
 
@@ -37,12 +40,12 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 		return
 	}
 
-	c, ok := req.ProviderData.(*services.Services)
+	c, ok := req.ProviderData.(*providerdata.Client)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *services.Services, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerdata.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -50,3 +53,58 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 
 	r.client = c
 }
+
+// ModifyPlan validates the machine type and storage and checks if a version change can be applied in place
+// replacing the instance fails if deletion protection is enabled
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Return early if we are deleting (plan is null)
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan Instance
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.validatePlan(ctx, &resp.Diagnostics, plan)
+
+	// Return early if we are creating (state is null)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state Instance
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Version.IsUnknown() || plan.Version.Equal(state.Version) {
+		return
+	}
+
+	available, err := r.listVersions(ctx, state.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list Postgres versions", err.Error())
+		return
+	}
+
+	err = common.ValidateVersionUpgrade(state.Version.ValueString(), plan.Version.ValueString(), available, false)
+	if err == nil {
+		return
+	}
+	if errors.Is(err, common.ErrVersionUnavailable) || !plan.AllowReplaceOnVersionChange.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("version"), "version can't be changed in place", err.Error())
+		return
+	}
+
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("version"))
+	common.PreventProtectedReplacement(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.AddAttributeWarning(path.Root("version"), "changing the version requires replacement",
+		fmt.Sprintf("%s\nthe instance will be recreated since `allow_replace_on_version_change` is enabled", err.Error()))
+}
@@ -45,6 +45,18 @@ func TestAcc_PostgresFlexInstance(t *testing.T) {
 					resource.TestCheckResourceAttrSet("stackit_postgres_flex_instance.example", "id"),
 				),
 			},
+			// check parameters and extensions are read back from the instance options
+			{
+				Config: configWithParameters(name1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_postgres_flex_instance.example", "name", name1),
+					resource.TestCheckResourceAttr("stackit_postgres_flex_instance.example", "parameters.log_statement", "ddl"),
+					resource.TestCheckResourceAttr("stackit_postgres_flex_instance.example", "parameters.max_connections", "200"),
+					resource.TestCheckResourceAttr("stackit_postgres_flex_instance.example", "extensions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("stackit_postgres_flex_instance.example", "extensions.*", "pg_stat_statements"),
+					resource.TestCheckTypeSetElemAttr("stackit_postgres_flex_instance.example", "extensions.*", "postgis"),
+				),
+			},
 			// test import
 			{
 				Config: config(name2),
@@ -101,3 +113,30 @@ func config(name string) string {
 		postgresinstance.DefaultStorageSize,
 	)
 }
+
+func configWithParameters(name string) string {
+	return fmt.Sprintf(`
+	resource "stackit_postgres_flex_instance" "example" {
+		name         = "%s"
+		project_id   = "%s"
+		machine_type = "%s"
+		version      = "14"
+		replicas     = 1
+		storage 	 = {
+			class = "premium-perf6-stackit"
+			size  = %d
+		}
+		acl = ["193.148.160.0/19","45.129.40.1/21"]
+		parameters = {
+			log_statement   = "ddl"
+			max_connections = "200"
+		}
+		extensions = ["pg_stat_statements", "postgis"]
+	}
+	  `,
+		name,
+		common.GetAcceptanceTestsProjectID(),
+		postgresinstance.DefaultMachineType,
+		postgresinstance.DefaultStorageSize,
+	)
+}
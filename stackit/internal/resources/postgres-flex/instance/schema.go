@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,18 +21,23 @@ import (
 
 // Instance is the schema model
 type Instance struct {
-	ID             types.String      `tfsdk:"id"`
-	Name           types.String      `tfsdk:"name"`
-	ProjectID      types.String      `tfsdk:"project_id"`
-	MachineType    types.String      `tfsdk:"machine_type"`
-	Version        types.String      `tfsdk:"version"`
-	Replicas       types.Int64       `tfsdk:"replicas"`
-	BackupSchedule types.String      `tfsdk:"backup_schedule"`
-	Options        map[string]string `tfsdk:"options"`
-	Labels         map[string]string `tfsdk:"labels"`
-	ACL            types.Set         `tfsdk:"acl"`
-	Storage        types.Object      `tfsdk:"storage"`
-	Timeouts       timeouts.Value    `tfsdk:"timeouts"`
+	ID                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	ProjectID          types.String      `tfsdk:"project_id"`
+	MachineType        types.String      `tfsdk:"machine_type"`
+	Version            types.String      `tfsdk:"version"`
+	Replicas           types.Int64       `tfsdk:"replicas"`
+	BackupSchedule     types.String      `tfsdk:"backup_schedule"`
+	Options            map[string]string `tfsdk:"options"`
+	Parameters         map[string]string `tfsdk:"parameters"`
+	Extensions         types.Set         `tfsdk:"extensions"`
+	Labels             map[string]string `tfsdk:"labels"`
+	ACL                types.Set         `tfsdk:"acl"`
+	Storage            types.Object      `tfsdk:"storage"`
+	DeletionProtection types.Bool        `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value    `tfsdk:"timeouts"`
+
+	AllowReplaceOnVersionChange types.Bool `tfsdk:"allow_replace_on_version_change"`
 }
 
 // Storage represent instance storage
@@ -65,15 +71,15 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					validate.ProjectID(),
 				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					common.ReplaceUnlessProtected{},
 				},
 			},
 			"machine_type": schema.StringAttribute{
-				Description: "The Machine Type. Available options: `2.4` (2 CPU, 4 Memory), `2.16` (2 CPU, 16 Memory), `4.8` (4 CPU, 8 Memory), `4.32` (4 CPU, 32 Memory), `8.16` (8 CPU, 16 Memory), `16.32` (16 CPU, 32 Memory), `16.128` (16 CPU, 128 Memory)",
+				Description: "The Machine Type. Available options can be listed with the `stackit_postgres_flex_flavors` data source and are validated at plan time",
 				Required:    true,
 			},
 			"version": schema.StringAttribute{
-				Description: "Postgres version. Options: `12`, `13`, `14`. Changing this value requires the resource to be recreated.",
+				Description: "Postgres version. Options: `12`, `13`, `14`. Major version upgrades are done in place, downgrades require `allow_replace_on_version_change` to be set.",
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
@@ -102,7 +108,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:    true,
 				Attributes: map[string]schema.Attribute{
 					"class": schema.StringAttribute{
-						Description: "Specifies the storage class. Available option: `premium-perf6-stackit`",
+						Description: "Specifies the storage class (default: `premium-perf6-stackit`). Available options can be listed with the `stackit_postgres_flex_storages` data source",
 						Optional:    true,
 						Computed:    true,
 						Default:     stringdefault.StaticString(DefaultStorageClass),
@@ -116,7 +122,18 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				},
 			},
 			"options": schema.MapAttribute{
-				Description: "Specifies postgres instance options",
+				Description:        "Specifies postgres instance options. Keys can't overlap with `parameters`, and `extensions` can't be set here if the `extensions` attribute is set",
+				ElementType:        types.StringType,
+				Optional:           true,
+				DeprecationMessage: "use `parameters` and `extensions` instead",
+			},
+			"parameters": schema.MapAttribute{
+				Description: "Specifies postgres server parameters. The parameters are validated against the selected `version`, supported parameters are: `default_transaction_isolation`, `idle_in_transaction_session_timeout`, `idle_session_timeout` (14+), `lock_timeout`, `log_connections`, `log_disconnections`, `log_min_duration_statement`, `log_statement`, `maintenance_work_mem`, `max_connections`, `statement_timeout`, `temp_file_limit`, `timezone`, `track_io_timing`, `work_mem`. Values are matched exactly, e.g. `on` instead of `On`. Removing a parameter resets it to the postgres default",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"extensions": schema.SetAttribute{
+				Description: "Specifies the extensions to enable, e.g. `pg_stat_statements` or `postgis`",
 				ElementType: types.StringType,
 				Optional:    true,
 			},
@@ -132,6 +149,13 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:    true,
 				Default:     common.GetDefaultACL(),
 			},
+			"allow_replace_on_version_change": schema.BoolAttribute{
+				Description: "Allow the instance to be recreated when the `version` change can't be applied in place (e.g. a downgrade). Default is `false`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"deletion_protection": common.DeletionProtection("instance"),
 			"timeouts": common.Timeouts(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -130,10 +130,13 @@ func upgradeV0(ctx context.Context, req resource.UpgradeStateRequest, resp *reso
 		Replicas:       oldState.Replicas,
 		BackupSchedule: oldState.BackupSchedule,
 		Options:        oldState.Options,
+		Extensions:     types.SetNull(types.StringType),
 		Labels:         oldState.Labels,
 		ACL:            acl,
 		Storage:        oldState.Storage,
 		Timeouts:       oldState.Timeouts,
+
+		AllowReplaceOnVersionChange: types.BoolValue(false),
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
@@ -9,7 +9,7 @@ import (
 	rmv2 "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
 	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/wait"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -30,14 +30,15 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	}
 
 	p := Project{
-		ID:                types.StringValue(plan.ID.ValueString()),
-		ContainerID:       types.StringValue(plan.ContainerID.ValueString()),
-		ParentContainerID: types.StringValue(plan.ParentContainerID.ValueString()),
-		Name:              types.StringValue(plan.Name.ValueString()),
-		BillingRef:        types.StringValue(plan.BillingRef.ValueString()),
-		OwnerEmail:        types.StringValue(plan.OwnerEmail.ValueString()),
-		Timeouts:          plan.Timeouts,
-		Labels:            plan.Labels,
+		ID:                 types.StringValue(plan.ID.ValueString()),
+		ContainerID:        types.StringValue(plan.ContainerID.ValueString()),
+		ParentContainerID:  types.StringValue(plan.ParentContainerID.ValueString()),
+		Name:               types.StringValue(plan.Name.ValueString()),
+		BillingRef:         types.StringValue(plan.BillingRef.ValueString()),
+		OwnerEmail:         types.StringValue(plan.OwnerEmail.ValueString()),
+		DeletionProtection: plan.DeletionProtection,
+		Timeouts:           plan.Timeouts,
+		Labels:             plan.Labels,
 	}
 	// update state
 	diags = resp.State.Set(ctx, p)
@@ -83,13 +84,26 @@ func (r Resource) createProject(ctx context.Context, resp *resource.CreateRespon
 		return plan
 	}
 
-	// give API a bit of time to process request
-	time.Sleep(30 * time.Second)
-
 	timeout, d := plan.Timeouts.Create(ctx, 30*time.Minute)
 	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
 		return plan
 	}
+
+	// the new project isn't immediately visible to the API
+	containerID := res.JSON201.ContainerID
+	if err := wait.Until(ctx, fmt.Sprintf("project %s to be visible", containerID), timeout, func(ctx context.Context) (bool, error) {
+		get, err := r.client.ResourceManagement.Get(ctx, containerID, &rmv2.GetParams{})
+		if wait.NotFound(get) {
+			return false, nil
+		}
+		if agg := clientValidate.Response(get, err, "JSON200"); agg != nil {
+			return false, wait.Retry(agg)
+		}
+		return true, nil
+	}); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed validating project %s creation", res.JSON201.ProjectID), err.Error())
+		return plan
+	}
 	process := res.WaitHandler(ctx, r.client.ResourceManagement, res.JSON201.ContainerID).SetTimeout(timeout)
 	if _, err := process.WaitWithContext(ctx); err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("failed validating project %s creation", res.JSON201.ProjectID), err.Error())
@@ -112,6 +126,8 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
+	common.ReadDeletionProtection(&p.DeletionProtection)
+
 	res, err := c.ResourceManagement.Get(ctx, p.ID.ValueString(), &rmv2.GetParams{})
 	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
 		resp.Diagnostics.AddError("failed reading project", agg.Error())
@@ -223,6 +239,10 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		return
 	}
 
+	if common.DeletionProtected(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+
 	c := r.client
 	res, err := c.ResourceManagement.Delete(ctx, state.ContainerID.ValueString())
 	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
@@ -244,15 +264,5 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 // ImportState handles terraform import
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// validate project id
-	if err := clientValidate.ProjectID(req.ID); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
-		)
-		return
-	}
-
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	common.ImportState(ctx, req, resp, common.ImportProjectID("id"))
 }
@@ -17,14 +17,15 @@ import (
 
 // Project is the schema model
 type Project struct {
-	ID                types.String      `tfsdk:"id"`
-	ContainerID       types.String      `tfsdk:"container_id"`
-	ParentContainerID types.String      `tfsdk:"parent_container_id"`
-	Name              types.String      `tfsdk:"name"`
-	BillingRef        types.String      `tfsdk:"billing_ref"`
-	OwnerEmail        types.String      `tfsdk:"owner_email"`
-	Timeouts          timeouts.Value    `tfsdk:"timeouts"`
-	Labels            map[string]string `tfsdk:"labels"`
+	ID                 types.String      `tfsdk:"id"`
+	ContainerID        types.String      `tfsdk:"container_id"`
+	ParentContainerID  types.String      `tfsdk:"parent_container_id"`
+	Name               types.String      `tfsdk:"name"`
+	BillingRef         types.String      `tfsdk:"billing_ref"`
+	OwnerEmail         types.String      `tfsdk:"owner_email"`
+	DeletionProtection types.Bool        `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value    `tfsdk:"timeouts"`
+	Labels             map[string]string `tfsdk:"labels"`
 }
 
 // Schema returns the terraform schema structure
@@ -83,6 +84,8 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Required:    true,
 			},
 
+			"deletion_protection": common.DeletionProtection("project"),
+
 			"timeouts": common.Timeouts(ctx, timeouts.Opts{
 				Create: true,
 				Delete: true,
@@ -0,0 +1,115 @@
+package publicip
+
+import (
+	"context"
+	"net/http"
+
+	iaas_publicip "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/publicip"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PublicIP
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(plan.ProjectID.ValueString())
+	res, err := r.client.IAAS.PublicIP.V1CreatePublicIP(ctx, projectID, iaas_publicip.V1CreatePublicIPJSONRequestBody{
+		NetworkInterface: plan.NetworkInterfaceID.ValueStringPointer(),
+		Labels:           common.FromLabels(plan.Labels),
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed creating public IP", agg.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(res.JSON201.ID.String())
+	plan.IP = types.StringPointerValue(res.JSON201.Ip)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PublicIP
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(state.ProjectID.ValueString())
+	ipID := uuid.MustParse(state.ID.ValueString())
+	res, err := r.client.IAAS.PublicIP.V1GetPublicIP(ctx, projectID, ipID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading public IP", agg.Error())
+		return
+	}
+
+	ip := res.JSON200
+	state.IP = types.StringPointerValue(ip.Ip)
+	state.Labels = common.ToLabels(ip.Labels)
+	state.NetworkInterfaceID = types.StringNull()
+	if ip.NetworkInterface != nil && *ip.NetworkInterface != "" {
+		state.NetworkInterfaceID = types.StringPointerValue(ip.NetworkInterface)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PublicIP
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an empty network interface removes the association
+	nic := plan.NetworkInterfaceID.ValueString()
+	projectID := uuid.MustParse(plan.ProjectID.ValueString())
+	ipID := uuid.MustParse(plan.ID.ValueString())
+	res, err := r.client.IAAS.PublicIP.V1UpdatePublicIP(ctx, projectID, ipID, iaas_publicip.V1UpdatePublicIPJSONRequestBody{
+		NetworkInterface: &nic,
+		Labels:           common.FromLabels(plan.Labels),
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		resp.Diagnostics.AddError("failed updating public IP", agg.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PublicIP
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(state.ProjectID.ValueString())
+	ipID := uuid.MustParse(state.ID.ValueString())
+	res, err := r.client.IAAS.PublicIP.V1DeletePublicIP(ctx, projectID, ipID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil && !validate.StatusEquals(res, http.StatusNotFound) {
+		resp.Diagnostics.AddError("failed deleting public IP", agg.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("id"))
+}
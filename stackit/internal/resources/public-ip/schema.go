@@ -0,0 +1,71 @@
+package publicip
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PublicIP is the schema model
+type PublicIP struct {
+	ID                 types.String      `tfsdk:"id"`
+	ProjectID          types.String      `tfsdk:"project_id"`
+	IP                 types.String      `tfsdk:"ip"`
+	NetworkInterfaceID types.String      `tfsdk:"network_interface_id"`
+	Labels             map[string]string `tfsdk:"labels"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages public IP addresses, which can be associated with network interfaces of servers\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Description: "The public IP address.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_interface_id": schema.StringAttribute{
+				Description: "Specifies the UUID of the network interface the IP is associated with, e.g. `stackit_server.<name>.network_interfaces[0].id`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Specifies labels of the public IP.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
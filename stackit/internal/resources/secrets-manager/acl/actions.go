@@ -0,0 +1,95 @@
+package acl
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/acls"
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ACL
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := r.client
+	res, err := c.SecretsManager.Acls.Create(ctx, uuid.MustParse(plan.ProjectID.ValueString()), uuid.MustParse(plan.InstanceID.ValueString()), acls.AclCreate{
+		Cidr: plan.CIDR.ValueString(),
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed to create ACL", agg.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(res.JSON201.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ACL
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := r.client
+	res, err := c.SecretsManager.Acls.List(ctx, uuid.MustParse(state.ProjectID.ValueString()), uuid.MustParse(state.InstanceID.ValueString()))
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to list ACLs", agg.Error())
+		return
+	}
+
+	for _, acl := range res.JSON200.Acls {
+		if acl.ID == state.ID.ValueString() {
+			// keep the configured notation, e.g. a host address, if the API normalized it to the same prefix
+			if common.CanonicalCIDR(acl.Cidr) != common.CanonicalCIDR(state.CIDR.ValueString()) {
+				state.CIDR = types.StringValue(acl.Cidr)
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	// the ACL was removed outside of terraform
+	resp.State.RemoveResource(ctx)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ACL
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := r.client
+	res, err := c.SecretsManager.Acls.Delete(ctx, uuid.MustParse(state.ProjectID.ValueString()), uuid.MustParse(state.InstanceID.ValueString()), uuid.MustParse(state.ID.ValueString()))
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil && !clientValidate.StatusEquals(res, http.StatusNotFound) {
+		resp.Diagnostics.AddError("failed to delete ACL", agg.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("instance_id"), common.ImportUUID("acl_id", "id"))
+}
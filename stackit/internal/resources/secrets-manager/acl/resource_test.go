@@ -0,0 +1,88 @@
+package acl_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_SecretsManagerACL(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name, "193.148.160.0/19"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_secrets_manager_acl.example", "cidr", "193.148.160.0/19"),
+					resource.TestCheckResourceAttrSet("stackit_secrets_manager_acl.example", "id"),
+				),
+			},
+			// replace the ACL
+			{
+				Config: config(name, "45.129.40.0/21"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_secrets_manager_acl.example", "cidr", "45.129.40.0/21"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_secrets_manager_acl.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_secrets_manager_acl.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_secrets_manager_acl.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+					iid, ok := r.Primary.Attributes["instance_id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute instance_id")
+					}
+
+					return fmt.Sprintf("%s,%s,%s", common.GetAcceptanceTestsProjectID(), iid, id), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(name, cidr string) string {
+	return fmt.Sprintf(`
+	resource "stackit_secrets_manager_instance" "example" {
+		project_id = "%s"
+		name       = "%s"
+	}
+
+	resource "stackit_secrets_manager_acl" "example" {
+		project_id  = stackit_secrets_manager_instance.example.project_id
+		instance_id = stackit_secrets_manager_instance.example.id
+		cidr        = "%s"
+	}
+	`,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+		cidr,
+	)
+}
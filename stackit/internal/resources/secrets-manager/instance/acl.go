@@ -0,0 +1,120 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/acls"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// aclEntry is an existing ACL of an instance
+type aclEntry struct {
+	ID   string
+	CIDR string
+}
+
+// aclDiff is the minimal set of changes to get from the current to the wanted ACLs
+type aclDiff struct {
+	Create []string
+	Delete []aclEntry
+}
+
+// aclAPI abstracts the ACL calls so the reconciler can be tested without the API
+type aclAPI interface {
+	Create(ctx context.Context, cidr string) (string, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// diffACLs computes which CIDRs need to be created and which ACLs deleted
+// CIDRs are compared in their canonical form, so equal networks written differently aren't recreated
+func diffACLs(current []aclEntry, want []string) aclDiff {
+	keys := []string{}
+	for _, cidr := range want {
+		keys = append(keys, common.CanonicalCIDR(cidr))
+	}
+	create, remove := common.DiffEntries(current, func(acl aclEntry) string {
+		return common.CanonicalCIDR(acl.CIDR)
+	}, keys)
+	return aclDiff{Create: create, Delete: remove}
+}
+
+// applyACLDiff applies the diff in a single pass
+// new ACLs are created before old ones are deleted, so wanted access is never interrupted
+// on failure, the changes applied so far are rolled back
+func applyACLDiff(ctx context.Context, api aclAPI, d aclDiff) error {
+	created := []aclEntry{}
+	for _, cidr := range d.Create {
+		id, err := api.Create(ctx, cidr)
+		if err != nil {
+			err = fmt.Errorf("failed to create ACL %s: %w", cidr, err)
+			return errors.Join(err, rollbackACLs(ctx, api, created, nil))
+		}
+		created = append(created, aclEntry{ID: id, CIDR: cidr})
+	}
+
+	deleted := []aclEntry{}
+	for _, acl := range d.Delete {
+		if err := api.Delete(ctx, acl.ID); err != nil {
+			err = fmt.Errorf("failed to delete ACL %s: %w", acl.CIDR, err)
+			return errors.Join(err, rollbackACLs(ctx, api, created, deleted))
+		}
+		deleted = append(deleted, acl)
+	}
+	return nil
+}
+
+// rollbackACLs restores deleted ACLs and removes created ones
+func rollbackACLs(ctx context.Context, api aclAPI, created, deleted []aclEntry) error {
+	errs := []error{}
+	for _, acl := range deleted {
+		if _, err := api.Create(ctx, acl.CIDR); err != nil {
+			errs = append(errs, fmt.Errorf("rollback: failed to restore ACL %s: %w", acl.CIDR, err))
+		}
+	}
+	for _, acl := range created {
+		if err := api.Delete(ctx, acl.ID); err != nil {
+			errs = append(errs, fmt.Errorf("rollback: failed to remove ACL %s: %w", acl.CIDR, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// instanceACLs implements aclAPI for a single instance
+type instanceACLs struct {
+	r          Resource
+	projectID  uuid.UUID
+	instanceID uuid.UUID
+	diags      *diag.Diagnostics
+}
+
+func (a instanceACLs) Create(ctx context.Context, cidr string) (string, error) {
+	res, err := a.r.client.SecretsManager.Acls.Create(ctx, a.projectID, a.instanceID, acls.AclCreate{
+		Cidr: cidr,
+	})
+	if agg := common.Validate(a.diags, res, err, "JSON201"); agg != nil {
+		return "", agg
+	}
+	return res.JSON201.ID, nil
+}
+
+func (a instanceACLs) Delete(ctx context.Context, id string) error {
+	aclID, err := uuid.Parse(id)
+	if err != nil {
+		return fmt.Errorf("failed to parse ACL ID: %w", err)
+	}
+	res, err := a.r.client.SecretsManager.Acls.Delete(ctx, a.projectID, a.instanceID, aclID)
+	if agg := common.Validate(a.diags, res, err); agg != nil {
+		// the ACL is already gone
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			return nil
+		}
+		return agg
+	}
+	return nil
+}
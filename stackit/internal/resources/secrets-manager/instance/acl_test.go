@@ -0,0 +1,163 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+// fakeACLs stores ACLs in memory and fails on the configured CIDRs or IDs
+type fakeACLs struct {
+	acls   map[string]string
+	nextID int
+	fail   map[string]bool
+	calls  []string
+}
+
+func (f *fakeACLs) Create(_ context.Context, cidr string) (string, error) {
+	f.calls = append(f.calls, "create "+cidr)
+	if f.fail[cidr] {
+		return "", errors.New("create failed")
+	}
+	f.nextID++
+	id := fmt.Sprintf("new-%d", f.nextID)
+	f.acls[id] = cidr
+	return id, nil
+}
+
+func (f *fakeACLs) Delete(_ context.Context, id string) error {
+	f.calls = append(f.calls, "delete "+f.acls[id])
+	if f.fail[id] {
+		return errors.New("delete failed")
+	}
+	delete(f.acls, id)
+	return nil
+}
+
+func (f *fakeACLs) cidrs() []string {
+	out := []string{}
+	for _, cidr := range f.acls {
+		out = append(out, cidr)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestDiffACLs(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name    string
+		current []aclEntry
+		want    []string
+		diff    aclDiff
+	}{
+		{
+			name:    "unchanged",
+			current: []aclEntry{{ID: "1", CIDR: "10.0.0.0/8"}},
+			want:    []string{"10.0.0.0/8"},
+			diff:    aclDiff{Create: []string{}, Delete: []aclEntry{}},
+		},
+		{
+			name:    "add and remove",
+			current: []aclEntry{{ID: "1", CIDR: "10.0.0.0/8"}, {ID: "2", CIDR: "192.168.0.0/16"}},
+			want:    []string{"10.0.0.0/8", "172.16.0.0/12"},
+			diff:    aclDiff{Create: []string{"172.16.0.0/12"}, Delete: []aclEntry{{ID: "2", CIDR: "192.168.0.0/16"}}},
+		},
+		{
+			name:    "equal networks",
+			current: []aclEntry{{ID: "1", CIDR: "45.129.40.1/21"}},
+			want:    []string{"45.129.40.0/21"},
+			diff:    aclDiff{Create: []string{}, Delete: []aclEntry{}},
+		},
+		{
+			name:    "duplicates",
+			current: []aclEntry{{ID: "1", CIDR: "10.0.0.0/8"}, {ID: "2", CIDR: "10.0.0.0/8"}},
+			want:    []string{"10.0.0.0/8"},
+			diff:    aclDiff{Create: []string{}, Delete: []aclEntry{{ID: "2", CIDR: "10.0.0.0/8"}}},
+		},
+		{
+			name:    "clear",
+			current: []aclEntry{{ID: "2", CIDR: "192.168.0.0/16"}, {ID: "1", CIDR: "10.0.0.0/8"}},
+			want:    []string{},
+			diff:    aclDiff{Create: []string{}, Delete: []aclEntry{{ID: "1", CIDR: "10.0.0.0/8"}, {ID: "2", CIDR: "192.168.0.0/16"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffACLs(tt.current, tt.want); !reflect.DeepEqual(got, tt.diff) {
+				t.Errorf("diffACLs() = %+v, want %+v", got, tt.diff)
+			}
+		})
+	}
+}
+
+func TestApplyACLDiff(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	current := map[string]string{"1": "10.0.0.0/8", "2": "192.168.0.0/16"}
+	want := []string{"10.0.0.0/8", "172.16.0.0/12", "172.17.0.0/16"}
+
+	tests := []struct {
+		name    string
+		fail    map[string]bool
+		wantErr bool
+		result  []string
+		calls   []string
+	}{
+		{
+			name:   "success",
+			result: []string{"10.0.0.0/8", "172.16.0.0/12", "172.17.0.0/16"},
+			calls:  []string{"create 172.16.0.0/12", "create 172.17.0.0/16", "delete 192.168.0.0/16"},
+		},
+		{
+			name:    "create fails",
+			fail:    map[string]bool{"172.17.0.0/16": true},
+			wantErr: true,
+			result:  []string{"10.0.0.0/8", "192.168.0.0/16"},
+			calls:   []string{"create 172.16.0.0/12", "create 172.17.0.0/16", "delete 172.16.0.0/12"},
+		},
+		{
+			name:    "delete fails",
+			fail:    map[string]bool{"2": true},
+			wantErr: true,
+			result:  []string{"10.0.0.0/8", "192.168.0.0/16"},
+			calls:   []string{"create 172.16.0.0/12", "create 172.17.0.0/16", "delete 192.168.0.0/16", "delete 172.16.0.0/12", "delete 172.17.0.0/16"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &fakeACLs{acls: map[string]string{}, fail: tt.fail}
+			entries := []aclEntry{}
+			for id, cidr := range current {
+				api.acls[id] = cidr
+				entries = append(entries, aclEntry{ID: id, CIDR: cidr})
+			}
+
+			err := applyACLDiff(ctx, api, diffACLs(entries, want))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyACLDiff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := api.cidrs(); !reflect.DeepEqual(got, tt.result) {
+				t.Errorf("ACLs after apply = %v, want %v", got, tt.result)
+			}
+			if !reflect.DeepEqual(api.calls, tt.calls) {
+				t.Errorf("calls = %v, want %v", api.calls, tt.calls)
+			}
+		})
+	}
+}
@@ -3,22 +3,16 @@ package instance
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
-	"strings"
 
-	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/acls"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0/instances"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"k8s.io/utils/strings/slices"
 )
 
 // Create - lifecycle function
@@ -72,49 +66,27 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 
 }
 
+// manageACLs reconciles the instance ACLs with the planned ones
+// an empty set removes all ACLs
 func (r Resource) manageACLs(ctx context.Context, plan *Instance, diags *diag.Diagnostics) {
-	want, idsToRemove := []string{}, []string{}
+	want := []string{}
 	diags.Append(plan.ACL.ElementsAs(ctx, &want, true)...)
 	if diags.HasError() {
 		return
 	}
-	r.readACLs(ctx, plan, diags)
+	current := r.listACLs(ctx, *plan, diags)
 	if diags.HasError() {
 		return
 	}
-	c := r.client
-	res, err := c.SecretsManager.Acls.List(ctx, uuid.MustParse(plan.ProjectID.ValueString()), uuid.MustParse(plan.ID.ValueString()))
-	if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
-		diags.AddError("failed to get instance ACLs", agg.Error())
-		return
-	}
-	for _, el := range res.JSON200.Acls {
-		if !slices.Contains(want, el.Cidr) {
-			idsToRemove = append(idsToRemove, el.ID)
-			continue
-		}
-		// remove from want
-		if i := slices.Index(want, el.Cidr); i > -1 {
-			want = append(want[:i], want[i+1:]...)
-		}
-	}
-	// remove
-	for _, id := range idsToRemove {
-		_, err := c.SecretsManager.Acls.Delete(ctx, uuid.MustParse(plan.ProjectID.ValueString()), uuid.MustParse(plan.ID.ValueString()), uuid.MustParse(id))
-		if err != nil {
-			diags.AddError("failed to delete instance ACL", err.Error())
-			return
-		}
+
+	api := instanceACLs{
+		r:          r,
+		projectID:  uuid.MustParse(plan.ProjectID.ValueString()),
+		instanceID: uuid.MustParse(plan.ID.ValueString()),
+		diags:      diags,
 	}
-	// add
-	for _, cidr := range want {
-		_, err := c.SecretsManager.Acls.Create(ctx, uuid.MustParse(plan.ProjectID.ValueString()), uuid.MustParse(plan.ID.ValueString()), acls.AclCreate{
-			Cidr: cidr,
-		})
-		if err != nil {
-			diags.AddError("failed to create instance ACL", err.Error())
-			return
-		}
+	if err := applyACLDiff(ctx, api, diffACLs(current, want)); err != nil {
+		diags.AddError("failed to update instance ACLs", err.Error())
 	}
 }
 
@@ -156,19 +128,30 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 	}
 }
 
-func (r Resource) readACLs(ctx context.Context, config *Instance, diags *diag.Diagnostics) {
+func (r Resource) listACLs(ctx context.Context, config Instance, diags *diag.Diagnostics) []aclEntry {
 	c := r.client
 	res, err := c.SecretsManager.Acls.List(ctx, uuid.MustParse(config.ProjectID.ValueString()), uuid.MustParse(config.ID.ValueString()))
 	if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
 		diags.AddError("failed to get instance ACLs", agg.Error())
+		return nil
+	}
+	entries := []aclEntry{}
+	for _, el := range res.JSON200.Acls {
+		entries = append(entries, aclEntry{ID: el.ID, CIDR: el.Cidr})
+	}
+	return entries
+}
+
+func (r Resource) readACLs(ctx context.Context, config *Instance, diags *diag.Diagnostics) {
+	entries := r.listACLs(ctx, *config, diags)
+	if diags.HasError() {
 		return
 	}
 	els := []attr.Value{}
-	for _, el := range res.JSON200.Acls {
-		els = append(els, types.StringValue(el.Cidr))
+	for _, el := range entries {
+		els = append(els, types.StringValue(el.CIDR))
 	}
 	config.ACL = types.SetValueMust(types.StringType, els)
-	return
 }
 
 // Update - lifecycle function
@@ -185,7 +168,8 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 		return
 	}
 
-	if plan.ACL.Equal(state.ACL) {
+	// ACLs aren't managed by the instance if `acl` isn't configured
+	if plan.ACL.IsUnknown() || plan.ACL.Equal(state.ACL) {
 		return
 	}
 
@@ -224,33 +208,15 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 	resp.State.RemoveResource(ctx)
 }
 
-// ImportState handles terraform import
-func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `project_id,id` where `id` is the instance ID.\nInstead got: %q", req.ID),
-		)
-		return
-	}
-
-	// validate project id
-	if err := clientValidate.ProjectID(idParts[0]); err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate kubernetes_project_id.\n%s", err.Error()),
-		)
-		return
-	}
-
-	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
-
-	if resp.Diagnostics.HasError() {
-		return
+// ImportParts returns the parts of the import identifier `project_id,id`
+func ImportParts() []common.ImportPart {
+	return []common.ImportPart{
+		common.ImportProjectID(),
+		common.ImportUUID("id"),
 	}
+}
 
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, ImportParts()...)
 }
@@ -50,6 +50,13 @@ func TestAcc_SecretsManagerInstance(t *testing.T) {
 					resource.TestCheckResourceAttr("stackit_secrets_manager_instance.example", "acl.#", "1"),
 				),
 			},
+			// explicitly clear all ACLs
+			{
+				Config: config4(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_secrets_manager_instance.example", "acl.#", "0"),
+				),
+			},
 			// test import
 			{
 				ResourceName: "stackit_secrets_manager_instance.example",
@@ -109,3 +116,16 @@ resource "stackit_secrets_manager_instance" "example" {
 		name,
 	)
 }
+
+func config4(name string) string {
+	return fmt.Sprintf(`
+resource "stackit_secrets_manager_instance" "example" {
+	project_id         = "%s"
+	name               = "%s"
+	acl                = []
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+	)
+}
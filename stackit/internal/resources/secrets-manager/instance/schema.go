@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -56,10 +57,13 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				},
 			},
 			"acl": schema.SetAttribute{
-				Description: "Specifies the access list for the instance. Each item must be CIDR notation.",
+				Description: "Specifies the access list for the instance. Each item must be CIDR notation. If set, the ACLs of the instance are reconciled with it and an empty set removes all ACLs. Leave it unset when managing ACLs with `stackit_secrets_manager_acl`.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"frontend_url": schema.StringAttribute{
 				Description: "Specifies the frontend for managing secrets.",
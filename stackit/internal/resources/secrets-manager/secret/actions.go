@@ -0,0 +1,210 @@
+package secret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// EnvUsername holds the user name used to read a secret during import
+	EnvUsername = "STACKIT_SECRETS_MANAGER_USERNAME"
+
+	// EnvPassword holds the password used to read a secret during import
+	EnvPassword = "STACKIT_SECRETS_MANAGER_PASSWORD"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Secret
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v, err := NewVault(ctx, plan.APIURL.ValueString(), plan.InstanceID.ValueString(), plan.Username.ValueString(), plan.Password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to authenticate", err.Error())
+		return
+	}
+
+	var cas *int64
+	if plan.CAS.ValueBool() {
+		cas = new(int64)
+	}
+	if r.write(ctx, v, &plan, cas, &resp.Diagnostics); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.CustomMetadata.IsNull() {
+		if r.writeMetadata(ctx, v, plan, &resp.Diagnostics); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ID = plan.Path
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Secret
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v, err := NewVault(ctx, state.APIURL.ValueString(), state.InstanceID.ValueString(), state.Username.ValueString(), state.Password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to authenticate", err.Error())
+		return
+	}
+
+	s, err := v.Read(ctx, state.Path.ValueString(), 0)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read secret", err.Error())
+		return
+	}
+
+	data, diags := types.MapValueFrom(ctx, types.StringType, s.Data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Data = data
+	state.Version = types.Int64Value(s.Version)
+
+	// keep custom_metadata unset if it isn't configured
+	if len(s.CustomMetadata) > 0 || !state.CustomMetadata.IsNull() {
+		md, diags := types.MapValueFrom(ctx, types.StringType, s.CustomMetadata)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.CustomMetadata = md
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Secret
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v, err := NewVault(ctx, plan.APIURL.ValueString(), plan.InstanceID.ValueString(), plan.Username.ValueString(), plan.Password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to authenticate", err.Error())
+		return
+	}
+
+	plan.Version = state.Version
+	if !plan.Data.Equal(state.Data) {
+		var cas *int64
+		if plan.CAS.ValueBool() {
+			version := state.Version.ValueInt64()
+			cas = &version
+		}
+		if r.write(ctx, v, &plan, cas, &resp.Diagnostics); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.CustomMetadata.Equal(state.CustomMetadata) {
+		if r.writeMetadata(ctx, v, plan, &resp.Diagnostics); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Secret
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	v, err := NewVault(ctx, state.APIURL.ValueString(), state.InstanceID.ValueString(), state.Username.ValueString(), state.Password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to authenticate", err.Error())
+		return
+	}
+
+	if err := v.Delete(ctx, state.Path.ValueString()); err != nil && !errors.Is(err, ErrNotFound) {
+		resp.Diagnostics.AddError("failed to delete secret", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// write creates a new version of the secret and sets the version in the plan
+func (r Resource) write(ctx context.Context, v *Vault, plan *Secret, cas *int64, diags *diag.Diagnostics) {
+	data := map[string]string{}
+	diags.Append(plan.Data.ElementsAs(ctx, &data, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	version, err := v.Write(ctx, plan.Path.ValueString(), data, cas)
+	if err != nil {
+		diags.AddError("failed to write secret", err.Error())
+		return
+	}
+	plan.Version = types.Int64Value(version)
+}
+
+func (r Resource) writeMetadata(ctx context.Context, v *Vault, plan Secret, diags *diag.Diagnostics) {
+	md := map[string]string{}
+	if !plan.CustomMetadata.IsNull() {
+		diags.Append(plan.CustomMetadata.ElementsAs(ctx, &md, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	if err := v.WriteMetadata(ctx, plan.Path.ValueString(), md); err != nil {
+		diags.AddError("failed to write secret metadata", err.Error())
+	}
+}
+
+// ImportState handles terraform import
+// the credentials aren't part of the import identifier, they're read from the environment
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	username, password := os.Getenv(EnvUsername), os.Getenv(EnvPassword)
+	if username == "" || password == "" {
+		resp.Diagnostics.AddError(
+			"Missing Secrets Manager credentials",
+			fmt.Sprintf("Importing a secret requires the credentials of a Secrets Manager user to be set in %s and %s", EnvUsername, EnvPassword),
+		)
+		return
+	}
+
+	if _, ok := common.ImportState(ctx, req, resp,
+		common.ImportString("api_url"),
+		common.ImportUUID("instance_id"),
+		common.ImportString("path", "path", "id"),
+	); !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), username)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("password"), password)...)
+}
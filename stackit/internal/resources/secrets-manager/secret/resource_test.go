@@ -0,0 +1,110 @@
+package secret_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/secret"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_SecretsManagerSecret(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_secrets_manager_secret.example", "path", "app/db"),
+					resource.TestCheckResourceAttr("stackit_secrets_manager_secret.example", "data.user", "admin"),
+					resource.TestCheckResourceAttr("stackit_secrets_manager_secret.example", "custom_metadata.owner", "terraform"),
+					resource.TestCheckResourceAttr("stackit_secrets_manager_secret.example", "version", "1"),
+				),
+			},
+			// new version
+			{
+				Config: config(name, "root"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_secrets_manager_secret.example", "data.user", "root"),
+					resource.TestCheckResourceAttr("stackit_secrets_manager_secret.example", "version", "2"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_secrets_manager_secret.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_secrets_manager_secret.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_secrets_manager_secret.example")
+					}
+					a := r.Primary.Attributes
+					if err := os.Setenv(secret.EnvUsername, a["username"]); err != nil {
+						return "", err
+					}
+					if err := os.Setenv(secret.EnvPassword, a["password"]); err != nil {
+						return "", err
+					}
+
+					return fmt.Sprintf("%s,%s,%s", a["api_url"], a["instance_id"], a["path"]), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cas"},
+			},
+		},
+	})
+}
+
+func config(name, user string) string {
+	return fmt.Sprintf(`
+	resource "stackit_secrets_manager_instance" "example" {
+		project_id = "%s"
+		name       = "%s"
+	}
+
+	resource "stackit_secrets_manager_user" "example" {
+		project_id    = stackit_secrets_manager_instance.example.project_id
+		instance_id   = stackit_secrets_manager_instance.example.id
+		description   = "terraform"
+		write_enabled = true
+	}
+
+	resource "stackit_secrets_manager_secret" "example" {
+		api_url     = stackit_secrets_manager_instance.example.api_url
+		instance_id = stackit_secrets_manager_instance.example.id
+		username    = stackit_secrets_manager_user.example.username
+		password    = stackit_secrets_manager_user.example.password
+		path        = "app/db"
+		cas         = true
+		data = {
+			user     = "%s"
+			password = "s3cr3t"
+		}
+		custom_metadata = {
+			owner = "terraform"
+		}
+	}
+	`,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+		user,
+	)
+}
@@ -0,0 +1,105 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Secret is the schema model
+type Secret struct {
+	ID             types.String `tfsdk:"id"`
+	APIURL         types.String `tfsdk:"api_url"`
+	InstanceID     types.String `tfsdk:"instance_id"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	Path           types.String `tfsdk:"path"`
+	Data           types.Map    `tfsdk:"data"`
+	CustomMetadata types.Map    `tfsdk:"custom_metadata"`
+	CAS            types.Bool   `tfsdk:"cas"`
+	Version        types.Int64  `tfsdk:"version"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages secrets in the KV v2 engine of a Secrets Manager instance\n\n" +
+			"The secret is managed with the credentials of a `stackit_secrets_manager_user` with `write_enabled` against the instance's `api_url`. " +
+			"Deleting the resource permanently deletes all versions of the secret.\n\n" +
+			"Secrets are imported with `api_url,instance_id,path`, using the credentials set in `STACKIT_SECRETS_MANAGER_USERNAME` and `STACKIT_SECRETS_MANAGER_PASSWORD`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID, equal to `path`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_url": schema.StringAttribute{
+				Description: "The API URL of the Secrets Manager instance, e.g. `stackit_secrets_manager_instance.<name>.api_url`. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "Specifies the instance UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The user name used to authenticate against the instance.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The password used to authenticate against the instance.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Specifies the path of the secret, e.g. `app/database`. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"data": schema.MapAttribute{
+				Description: "Specifies the key value pairs of the secret. Every change creates a new version.",
+				Required:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"custom_metadata": schema.MapAttribute{
+				Description: "Specifies custom metadata of the secret, which isn't versioned.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"cas": schema.BoolAttribute{
+				Description: "If true, writes use check-and-set against the version known to Terraform: creation fails if the secret already exists and updates fail if it was changed in the meantime. `false` by default.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"version": schema.Int64Attribute{
+				Description: "The current version of the secret.",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,192 @@
+package secret
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned when the secret (version) doesn't exist or was deleted
+var ErrNotFound = errors.New("secret not found")
+
+// Vault is a minimal client for the Vault compatible KV v2 engine of a Secrets Manager instance
+// every instance mounts its KV engine at the instance ID and authenticates users with userpass
+type Vault struct {
+	url    string
+	mount  string
+	token  string
+	client *http.Client
+}
+
+// SecretVersion is a single version of a secret
+type SecretVersion struct {
+	Data           map[string]string
+	CustomMetadata map[string]string
+	Version        int64
+	CreatedTime    string
+}
+
+// NewVault logs in with the user's credentials against the instance's API URL
+func NewVault(ctx context.Context, apiURL, instanceID, username, password string) (*Vault, error) {
+	v := &Vault{
+		url:    strings.TrimSuffix(apiURL, "/"),
+		mount:  instanceID,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+
+	var res struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err := v.do(ctx, http.MethodPost, "/v1/auth/userpass/login/"+url.PathEscape(username), map[string]string{"password": password}, &res); err != nil {
+		return nil, fmt.Errorf("failed to login as '%s': %w", username, err)
+	}
+	if res.Auth.ClientToken == "" {
+		return nil, fmt.Errorf("failed to login as '%s': no token received", username)
+	}
+	v.token = res.Auth.ClientToken
+	return v, nil
+}
+
+// Write creates a new version of the secret and returns its version number
+// if cas is set, the write only succeeds if the current version matches it (0 = secret doesn't exist yet)
+func (v *Vault) Write(ctx context.Context, path string, data map[string]string, cas *int64) (int64, error) {
+	body := map[string]interface{}{
+		"data": data,
+	}
+	if cas != nil {
+		body["options"] = map[string]int64{"cas": *cas}
+	}
+	var res struct {
+		Data struct {
+			Version int64 `json:"version"`
+		} `json:"data"`
+	}
+	if err := v.do(ctx, http.MethodPost, v.path("data", path), body, &res); err != nil {
+		return 0, err
+	}
+	return res.Data.Version, nil
+}
+
+// Read returns the given version of the secret, or the latest one if version is 0
+func (v *Vault) Read(ctx context.Context, path string, version int64) (*SecretVersion, error) {
+	p := v.path("data", path)
+	if version > 0 {
+		p += "?version=" + strconv.FormatInt(version, 10)
+	}
+	var res struct {
+		Data struct {
+			Data     map[string]interface{} `json:"data"`
+			Metadata struct {
+				CreatedTime    string            `json:"created_time"`
+				CustomMetadata map[string]string `json:"custom_metadata"`
+				Version        int64             `json:"version"`
+			} `json:"metadata"`
+		} `json:"data"`
+	}
+	if err := v.do(ctx, http.MethodGet, p, nil, &res); err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]string, len(res.Data.Data))
+	for k, val := range res.Data.Data {
+		// values written outside of terraform aren't necessarily strings
+		if s, ok := val.(string); ok {
+			data[k] = s
+			continue
+		}
+		b, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		data[k] = string(b)
+	}
+
+	return &SecretVersion{
+		Data:           data,
+		CustomMetadata: res.Data.Metadata.CustomMetadata,
+		Version:        res.Data.Metadata.Version,
+		CreatedTime:    res.Data.Metadata.CreatedTime,
+	}, nil
+}
+
+// WriteMetadata replaces the custom metadata of the secret
+func (v *Vault) WriteMetadata(ctx context.Context, path string, customMetadata map[string]string) error {
+	if customMetadata == nil {
+		customMetadata = map[string]string{}
+	}
+	return v.do(ctx, http.MethodPost, v.path("metadata", path), map[string]interface{}{
+		"custom_metadata": customMetadata,
+	}, nil)
+}
+
+// Delete permanently deletes all versions and the metadata of the secret
+func (v *Vault) Delete(ctx context.Context, path string) error {
+	return v.do(ctx, http.MethodDelete, v.path("metadata", path), nil, nil)
+}
+
+func (v *Vault) path(kind, path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return fmt.Sprintf("/v1/%s/%s/%s", url.PathEscape(v.mount), kind, strings.Join(segments, "/"))
+}
+
+func (v *Vault) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, v.url+path, r)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if v.token != "" {
+		req.Header.Set("X-Vault-Token", v.token)
+	}
+
+	res, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		var e struct {
+			Errors []string `json:"errors"`
+		}
+		if json.Unmarshal(b, &e) == nil && len(e.Errors) > 0 {
+			return fmt.Errorf("%s %s: %d: %s", method, path, res.StatusCode, strings.Join(e.Errors, "; "))
+		}
+		return fmt.Errorf("%s %s: %d: %s", method, path, res.StatusCode, string(b))
+	}
+
+	if out == nil || len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
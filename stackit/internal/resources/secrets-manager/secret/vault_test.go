@@ -0,0 +1,147 @@
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+// fakeKV emulates the parts of the Vault KV v2 API used by the client
+type fakeKV struct {
+	versions [][]byte
+	metadata map[string]string
+}
+
+func (f *fakeKV) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/v1/auth/userpass/login/user":
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["password"] != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["invalid username or password"]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"auth":{"client_token":"token"}}`))
+		return
+	case r.Header.Get("X-Vault-Token") != "token":
+		w.WriteHeader(http.StatusForbidden)
+		return
+	case r.URL.Path == "/v1/mount/data/app/db" && r.Method == http.MethodPost:
+		var body struct {
+			Data    json.RawMessage  `json:"data"`
+			Options map[string]int64 `json:"options"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if cas, ok := body.Options["cas"]; ok && cas != int64(len(f.versions)) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["check-and-set parameter did not match the current version"]}`))
+			return
+		}
+		f.versions = append(f.versions, body.Data)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"version": len(f.versions)}})
+	case r.URL.Path == "/v1/mount/data/app/db" && r.Method == http.MethodGet:
+		version := len(f.versions)
+		if v := r.URL.Query().Get("version"); v != "" {
+			_ = json.Unmarshal([]byte(v), &version)
+		}
+		if version == 0 || version > len(f.versions) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{
+			"data": json.RawMessage(f.versions[version-1]),
+			"metadata": map[string]interface{}{
+				"version":         version,
+				"created_time":    "2023-01-01T00:00:00Z",
+				"custom_metadata": f.metadata,
+			},
+		}})
+	case r.URL.Path == "/v1/mount/metadata/app/db" && r.Method == http.MethodPost:
+		var body struct {
+			CustomMetadata map[string]string `json:"custom_metadata"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.metadata = body.CustomMetadata
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Path == "/v1/mount/metadata/app/db" && r.Method == http.MethodDelete:
+		f.versions = nil
+		f.metadata = nil
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestVault(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	kv := &fakeKV{}
+	srv := httptest.NewServer(kv)
+	defer srv.Close()
+
+	if _, err := NewVault(ctx, srv.URL, "mount", "user", "wrong"); err == nil || !strings.Contains(err.Error(), "invalid username or password") {
+		t.Fatalf("expected login error, got %v", err)
+	}
+
+	v, err := NewVault(ctx, srv.URL+"/", "mount", "user", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := v.Read(ctx, "app/db", 0); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	zero := int64(0)
+	version, err := v.Write(ctx, "/app/db/", map[string]string{"user": "admin"}, &zero)
+	if err != nil || version != 1 {
+		t.Fatalf("Write() = %d, %v", version, err)
+	}
+	if _, err := v.Write(ctx, "app/db", map[string]string{"user": "other"}, &zero); err == nil {
+		t.Fatal("expected check-and-set error")
+	}
+	kv.versions = append(kv.versions, []byte(`{"user":"root","port":5432}`))
+
+	if err := v.WriteMetadata(ctx, "app/db", map[string]string{"owner": "team"}); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := v.Read(ctx, "app/db", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &SecretVersion{
+		Data:           map[string]string{"user": "root", "port": "5432"},
+		CustomMetadata: map[string]string{"owner": "team"},
+		Version:        2,
+		CreatedTime:    "2023-01-01T00:00:00Z",
+	}
+	if !reflect.DeepEqual(s, want) {
+		t.Errorf("Read() = %+v, want %+v", s, want)
+	}
+
+	s, err = v.Read(ctx, "app/db", 1)
+	if err != nil || s.Data["user"] != "admin" || s.Version != 1 {
+		t.Errorf("Read(version 1) = %+v, %v", s, err)
+	}
+
+	if err := v.Delete(ctx, "app/db"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.Read(ctx, "app/db", 0); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got %v", err)
+	}
+}
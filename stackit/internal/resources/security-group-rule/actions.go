@@ -0,0 +1,131 @@
+package securitygrouprule
+
+import (
+	"context"
+	"net/http"
+
+	iaas_securitygroup "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/securitygroup"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Rule
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	etherType := plan.EtherType.ValueString()
+	body := iaas_securitygroup.V1CreateSecurityGroupRuleJSONRequestBody{
+		Direction:   plan.Direction.ValueString(),
+		Ethertype:   &etherType,
+		IpRange:     plan.IPRange.ValueStringPointer(),
+		Description: plan.Description.ValueStringPointer(),
+	}
+	if !plan.Protocol.IsNull() {
+		body.Protocol = &iaas_securitygroup.V1CreateProtocol{
+			Name: plan.Protocol.ValueStringPointer(),
+		}
+	}
+	if !plan.PortRangeMin.IsNull() {
+		body.PortRange = &iaas_securitygroup.V1PortRange{
+			Min: int(plan.PortRangeMin.ValueInt64()),
+			Max: int(plan.PortRangeMax.ValueInt64()),
+		}
+	}
+	if !plan.RemoteSecurityGroupID.IsNull() {
+		id := uuid.MustParse(plan.RemoteSecurityGroupID.ValueString())
+		body.RemoteSecurityGroupID = &id
+	}
+
+	projectID := uuid.MustParse(plan.ProjectID.ValueString())
+	groupID := uuid.MustParse(plan.SecurityGroupID.ValueString())
+	res, err := r.client.IAAS.SecurityGroup.V1CreateSecurityGroupRule(ctx, projectID, groupID, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed creating security group rule", agg.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(res.JSON201.ID.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Rule
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(state.ProjectID.ValueString())
+	groupID := uuid.MustParse(state.SecurityGroupID.ValueString())
+	ruleID := uuid.MustParse(state.ID.ValueString())
+	res, err := r.client.IAAS.SecurityGroup.V1GetSecurityGroupRule(ctx, projectID, groupID, ruleID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading security group rule", agg.Error())
+		return
+	}
+
+	// rules are immutable, so the attributes are only read after an import
+	// this keeps protocol numbers and names as configured
+	if !state.Direction.IsNull() {
+		return
+	}
+
+	rule := res.JSON200
+	state.Direction = types.StringValue(rule.Direction)
+	state.EtherType = types.StringPointerValue(rule.Ethertype)
+	state.IPRange = types.StringPointerValue(rule.IpRange)
+	state.Description = types.StringPointerValue(rule.Description)
+	if rule.Protocol != nil {
+		state.Protocol = types.StringPointerValue(rule.Protocol.Name)
+	}
+	if rule.PortRange != nil {
+		state.PortRangeMin = types.Int64Value(int64(rule.PortRange.Min))
+		state.PortRangeMax = types.Int64Value(int64(rule.PortRange.Max))
+	}
+	if rule.RemoteSecurityGroupID != nil {
+		state.RemoteSecurityGroupID = types.StringValue(rule.RemoteSecurityGroupID.String())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Rule
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(state.ProjectID.ValueString())
+	groupID := uuid.MustParse(state.SecurityGroupID.ValueString())
+	ruleID := uuid.MustParse(state.ID.ValueString())
+	res, err := r.client.IAAS.SecurityGroup.V1DeleteSecurityGroupRule(ctx, projectID, groupID, ruleID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil && !validate.StatusEquals(res, http.StatusNotFound) {
+		resp.Diagnostics.AddError("failed deleting security group rule", agg.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("security_group_id"), common.ImportUUID("id"))
+}
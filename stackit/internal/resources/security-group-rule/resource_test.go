@@ -0,0 +1,87 @@
+package securitygrouprule_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_SecurityGroupRule(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_security_group_rule.example", "direction", "ingress"),
+					resource.TestCheckResourceAttr("stackit_security_group_rule.example", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("stackit_security_group_rule.example", "port_range_min", "22"),
+					resource.TestCheckResourceAttr("stackit_security_group_rule.example", "port_range_max", "22"),
+					resource.TestCheckResourceAttr("stackit_security_group_rule.example", "ip_range", "0.0.0.0/0"),
+					resource.TestCheckResourceAttrPair("stackit_security_group_rule.example", "security_group_id", "stackit_security_group.example", "id"),
+					resource.TestCheckResourceAttrSet("stackit_security_group_rule.example", "id"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_security_group_rule.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_security_group_rule.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_security_group_rule.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+
+					return fmt.Sprintf("%s,%s,%s", common.GetAcceptanceTestsProjectID(), r.Primary.Attributes["security_group_id"], id), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(name string) string {
+	return fmt.Sprintf(`
+resource "stackit_security_group" "example" {
+	project_id = "%s"
+	name       = "%s"
+}
+
+resource "stackit_security_group_rule" "example" {
+	project_id        = stackit_security_group.example.project_id
+	security_group_id = stackit_security_group.example.id
+	direction         = "ingress"
+	protocol          = "tcp"
+	port_range_min    = 22
+	port_range_max    = 22
+	ip_range          = "0.0.0.0/0"
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+	)
+}
@@ -0,0 +1,168 @@
+package securitygrouprule
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	DirectionIngress = "ingress"
+	DirectionEgress  = "egress"
+
+	EtherTypeIPv4 = "IPv4"
+	EtherTypeIPv6 = "IPv6"
+)
+
+// Rule is the schema model
+type Rule struct {
+	ID                    types.String `tfsdk:"id"`
+	ProjectID             types.String `tfsdk:"project_id"`
+	SecurityGroupID       types.String `tfsdk:"security_group_id"`
+	Direction             types.String `tfsdk:"direction"`
+	EtherType             types.String `tfsdk:"ether_type"`
+	Protocol              types.String `tfsdk:"protocol"`
+	PortRangeMin          types.Int64  `tfsdk:"port_range_min"`
+	PortRangeMax          types.Int64  `tfsdk:"port_range_max"`
+	IPRange               types.String `tfsdk:"ip_range"`
+	RemoteSecurityGroupID types.String `tfsdk:"remote_security_group_id"`
+	Description           types.String `tfsdk:"description"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages a rule of a security group. Rules can't be changed, therefore every change requires the rule to be recreated.\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Description: "Specifies the security group UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"direction": schema.StringAttribute{
+				Description: fmt.Sprintf("Specifies the traffic direction, either `%s` or `%s`. Changing this value requires the resource to be recreated.", DirectionIngress, DirectionEgress),
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(DirectionIngress, DirectionEgress),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ether_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Specifies the IP version, either `%s` or `%s`. Default is `%s`. Changing this value requires the resource to be recreated.", EtherTypeIPv4, EtherTypeIPv6, EtherTypeIPv4),
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(EtherTypeIPv4),
+				Validators: []validator.String{
+					stringvalidator.OneOf(EtherTypeIPv4, EtherTypeIPv6),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Description: "Specifies the protocol name (e.g. `tcp`, `udp`, `icmp`) or number. All protocols are matched if unset. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port_range_min": schema.Int64Attribute{
+				Description: "Specifies the first port of the matched range. Only valid for `tcp` and `udp`. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+					int64validator.AlsoRequires(path.MatchRoot("port_range_max"), path.MatchRoot("protocol")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"port_range_max": schema.Int64Attribute{
+				Description: "Specifies the last port of the matched range. Only valid for `tcp` and `udp`. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+					int64validator.AlsoRequires(path.MatchRoot("port_range_min")),
+					int64validator.AtLeastSumOf(path.MatchRoot("port_range_min")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"ip_range": schema.StringAttribute{
+				Description: "Specifies the matched remote network in CIDR notation. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.StringWith(func(s string) error {
+						_, _, err := net.ParseCIDR(s)
+						return err
+					}, "validate CIDR notation"),
+					stringvalidator.ConflictsWith(path.MatchRoot("remote_security_group_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote_security_group_id": schema.StringAttribute{
+				Description: "Specifies the UUID of a security group whose members are matched. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Specifies the description of the rule. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(127),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,120 @@
+package securitygroup
+
+import (
+	"context"
+	"net/http"
+
+	iaas_securitygroup "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/securitygroup"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SecurityGroup
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateful := plan.Stateful.ValueBool()
+	projectID := uuid.MustParse(plan.ProjectID.ValueString())
+	res, err := r.client.IAAS.SecurityGroup.V1CreateSecurityGroup(ctx, projectID, iaas_securitygroup.V1CreateSecurityGroupJSONRequestBody{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueStringPointer(),
+		Stateful:    &stateful,
+		Labels:      common.FromLabels(plan.Labels),
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed creating security group", agg.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(res.JSON201.ID.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SecurityGroup
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(state.ProjectID.ValueString())
+	groupID := uuid.MustParse(state.ID.ValueString())
+	res, err := r.client.IAAS.SecurityGroup.V1GetSecurityGroup(ctx, projectID, groupID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading security group", agg.Error())
+		return
+	}
+
+	sg := res.JSON200
+	state.Name = types.StringValue(sg.Name)
+	state.Stateful = types.BoolPointerValue(sg.Stateful)
+	state.Labels = common.ToLabels(sg.Labels)
+	if sg.Description != nil && *sg.Description != "" {
+		state.Description = types.StringPointerValue(sg.Description)
+	} else {
+		state.Description = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SecurityGroup
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	description := plan.Description.ValueString()
+	projectID := uuid.MustParse(plan.ProjectID.ValueString())
+	groupID := uuid.MustParse(plan.ID.ValueString())
+	res, err := r.client.IAAS.SecurityGroup.V1UpdateSecurityGroup(ctx, projectID, groupID, iaas_securitygroup.V1UpdateSecurityGroupJSONRequestBody{
+		Name:        &name,
+		Description: &description,
+		Labels:      common.FromLabels(plan.Labels),
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		resp.Diagnostics.AddError("failed updating security group", agg.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SecurityGroup
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(state.ProjectID.ValueString())
+	groupID := uuid.MustParse(state.ID.ValueString())
+	res, err := r.client.IAAS.SecurityGroup.V1DeleteSecurityGroup(ctx, projectID, groupID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil && !validate.StatusEquals(res, http.StatusNotFound) {
+		resp.Diagnostics.AddError("failed deleting security group", agg.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("id"))
+}
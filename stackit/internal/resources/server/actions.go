@@ -0,0 +1,312 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	iaas_server "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/server"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/wait"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Server
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.createBody(ctx, plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(plan.ProjectID.ValueString())
+	res, err := r.client.IAAS.Server.V1CreateServer(ctx, projectID, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed creating server", agg.Error())
+		return
+	}
+
+	serverID := res.JSON201.ID
+	plan.ID = types.StringValue(serverID.String())
+
+	// save the ID, so the server isn't lost if waiting fails
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), plan.ProjectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, 20*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	process := res.WaitHandler(ctx, r.client.IAAS.Server, projectID, serverID).SetTimeout(timeout)
+	if _, err := process.WaitWithContext(ctx); err != nil {
+		resp.Diagnostics.AddError("failed validating server creation", err.Error())
+		return
+	}
+
+	// the primary network is attached on creation, additional networks afterwards
+	for _, n := range plan.Networks[1:] {
+		networkID := uuid.MustParse(n.ValueString())
+		res, err := r.client.IAAS.Server.V1AddNetworkToServer(ctx, projectID, serverID, networkID)
+		if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed attaching network %s to server", networkID), agg.Error())
+			return
+		}
+	}
+
+	s, err := r.waitForNetworks(ctx, projectID, serverID, plan.Networks, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("failed validating server network attachment", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(transform(&plan, s)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r Resource) createBody(ctx context.Context, plan Server) (iaas_server.V1CreateServerJSONRequestBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	az := DefaultAvailabilityZone
+	if !plan.AvailabilityZone.IsUnknown() && !plan.AvailabilityZone.IsNull() {
+		az = plan.AvailabilityZone.ValueString()
+	}
+	body := iaas_server.V1CreateServerJSONRequestBody{
+		Name:             plan.Name.ValueString(),
+		MachineType:      plan.MachineType.ValueString(),
+		AvailabilityZone: &az,
+		Networking: iaas_server.V1CreateServerNetworking{
+			NetworkID: uuid.MustParse(plan.Networks[0].ValueString()),
+		},
+		Labels: common.FromLabels(plan.Labels),
+	}
+
+	if !plan.ImageID.IsNull() {
+		id := uuid.MustParse(plan.ImageID.ValueString())
+		body.ImageID = &id
+	}
+
+	if plan.BootVolume != nil {
+		bv := &iaas_server.V1CreateServerBootVolume{
+			PerformanceClass:    plan.BootVolume.PerformanceClass.ValueStringPointer(),
+			DeleteOnTermination: plan.BootVolume.DeleteOnTermination.ValueBoolPointer(),
+			Source: &iaas_server.V1BootVolumeSource{
+				Type: plan.BootVolume.SourceType.ValueString(),
+				ID:   uuid.MustParse(plan.BootVolume.SourceID.ValueString()),
+			},
+		}
+		if !plan.BootVolume.Size.IsNull() {
+			size := int(plan.BootVolume.Size.ValueInt64())
+			bv.Size = &size
+		}
+		body.BootVolume = bv
+	}
+
+	if !plan.KeyPairName.IsNull() {
+		body.KeypairName = plan.KeyPairName.ValueStringPointer()
+	}
+
+	if !plan.SecurityGroups.IsNull() {
+		groups := []string{}
+		diags.Append(plan.SecurityGroups.ElementsAs(ctx, &groups, false)...)
+		body.SecurityGroups = &groups
+	}
+
+	if !plan.UserData.IsNull() {
+		userData := base64.StdEncoding.EncodeToString([]byte(plan.UserData.ValueString()))
+		body.UserData = &userData
+	}
+
+	return body, diags
+}
+
+// waitForNetworks waits until the server has a network interface in every given network
+// networks are attached asynchronously, so the interfaces show up after V1AddNetworkToServer returned
+func (r Resource) waitForNetworks(ctx context.Context, projectID, serverID uuid.UUID, networks []types.String, timeout time.Duration) (iaas_server.V1Server, error) {
+	var s iaas_server.V1Server
+	err := wait.Until(ctx, fmt.Sprintf("networks to be attached to server %s in project %s", serverID, projectID), timeout, func(ctx context.Context) (bool, error) {
+		res, err := r.client.IAAS.Server.V1GetServer(ctx, projectID, serverID)
+		if agg := validate.Response(res, err, "JSON200"); agg != nil {
+			return false, wait.Retry(agg)
+		}
+		s = *res.JSON200
+
+		attached := map[string]bool{}
+		if s.Nics != nil {
+			for _, nic := range *s.Nics {
+				attached[nic.NetworkID.String()] = true
+			}
+		}
+		for _, n := range networks {
+			if !attached[n.ValueString()] {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	return s, err
+}
+
+func transform(state *Server, s iaas_server.V1Server) diag.Diagnostics {
+	state.ID = types.StringValue(s.ID.String())
+	state.Name = types.StringValue(s.Name)
+	state.MachineType = types.StringValue(s.MachineType)
+	state.AvailabilityZone = types.StringPointerValue(s.AvailabilityZone)
+	state.Labels = common.ToLabels(s.Labels)
+	state.Status = types.StringPointerValue(s.Status)
+
+	// after an import, the configuration only known by the API is restored
+	if state.ImageID.IsNull() && state.BootVolume == nil && s.ImageID != nil {
+		state.ImageID = types.StringValue(s.ImageID.String())
+	}
+	if state.KeyPairName.IsNull() && s.KeypairName != nil {
+		state.KeyPairName = types.StringPointerValue(s.KeypairName)
+	}
+
+	apiNics := []iaas_server.V1Nic{}
+	if s.Nics != nil {
+		apiNics = orderNics(*s.Nics, state.Networks)
+	}
+	nics := []attr.Value{}
+	networks := []types.String{}
+	for _, nic := range apiNics {
+		networks = append(networks, types.StringValue(nic.NetworkID.String()))
+		nics = append(nics, types.ObjectValueMust(networkInterfaceType.AttrTypes, map[string]attr.Value{
+			"id":         types.StringValue(nic.NicID.String()),
+			"network_id": types.StringValue(nic.NetworkID.String()),
+			"ipv4":       types.StringPointerValue(nic.Ipv4),
+		}))
+	}
+	state.Networks = networks
+
+	var diags diag.Diagnostics
+	state.NetworkInterfaces, diags = types.ListValue(networkInterfaceType, nics)
+	return diags
+}
+
+// orderNics sorts the network interfaces in the order of networks
+// the API doesn't guarantee an order, so it's only used if the interfaces match networks exactly
+// otherwise the API order is kept and the change of networks is detected as drift
+func orderNics(nics []iaas_server.V1Nic, networks []types.String) []iaas_server.V1Nic {
+	if len(nics) != len(networks) {
+		return nics
+	}
+	byNetwork := map[string]iaas_server.V1Nic{}
+	for _, nic := range nics {
+		byNetwork[nic.NetworkID.String()] = nic
+	}
+	ordered := []iaas_server.V1Nic{}
+	for _, n := range networks {
+		nic, ok := byNetwork[n.ValueString()]
+		if !ok {
+			return nics
+		}
+		ordered = append(ordered, nic)
+	}
+	return ordered
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Server
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(state.ProjectID.ValueString())
+	serverID := uuid.MustParse(state.ID.ValueString())
+	res, err := r.client.IAAS.Server.V1GetServer(ctx, projectID, serverID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading server", agg.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(transform(&state, *res.JSON200)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Server
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	projectID := uuid.MustParse(plan.ProjectID.ValueString())
+	serverID := uuid.MustParse(plan.ID.ValueString())
+	res, err := r.client.IAAS.Server.V1UpdateServer(ctx, projectID, serverID, iaas_server.V1UpdateServerJSONRequestBody{
+		Name:   &name,
+		Labels: common.FromLabels(plan.Labels),
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		resp.Diagnostics.AddError("failed updating server", agg.Error())
+		return
+	}
+
+	plan.Status = state.Status
+	plan.NetworkInterfaces = state.NetworkInterfaces
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Server
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(state.ProjectID.ValueString())
+	serverID := uuid.MustParse(state.ID.ValueString())
+	res, err := r.client.IAAS.Server.V1DeleteServer(ctx, projectID, serverID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed deleting server", agg.Error())
+		return
+	}
+
+	timeout, d := state.Timeouts.Delete(ctx, 20*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	process := res.WaitHandler(ctx, r.client.IAAS.Server, projectID, serverID).SetTimeout(timeout)
+	if _, err := process.WaitWithContext(ctx); err != nil {
+		resp.Diagnostics.AddError("failed to verify server deletion", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("id"))
+}
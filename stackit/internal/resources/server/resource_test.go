@@ -0,0 +1,102 @@
+package server_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_Server(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_server.example", "name", name),
+					resource.TestCheckResourceAttr("stackit_server.example", "project_id", common.GetAcceptanceTestsProjectID()),
+					resource.TestCheckResourceAttr("stackit_server.example", "machine_type", "g1.1"),
+					resource.TestCheckResourceAttr("stackit_server.example", "availability_zone", "eu01-1"),
+					resource.TestCheckResourceAttr("stackit_server.example", "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttrPair("stackit_server.example", "network_interfaces.0.network_id", "stackit_network.example", "id"),
+					resource.TestCheckResourceAttrSet("stackit_server.example", "network_interfaces.0.ipv4"),
+					resource.TestCheckResourceAttrSet("stackit_server.example", "status"),
+					resource.TestCheckResourceAttrSet("stackit_server.example", "id"),
+				),
+			},
+			// check rename
+			{
+				Config: config(name, name+"-new"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_server.example", "name", name+"-new"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_server.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_server.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_server.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+
+					return fmt.Sprintf("%s,%s", common.GetAcceptanceTestsProjectID(), id), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"boot_volume", "image_id", "timeouts"},
+			},
+		},
+	})
+}
+
+func config(name, serverName string) string {
+	return fmt.Sprintf(`
+resource "stackit_network" "example" {
+	project_id  = "%s"
+	name        = "%s"
+	nameservers = ["8.8.8.8"]
+}
+
+resource "stackit_server" "example" {
+	project_id   = stackit_network.example.project_id
+	name         = "%s"
+	machine_type = "g1.1"
+	networks     = [stackit_network.example.id]
+
+	boot_volume = {
+		size        = 32
+		source_type = "image"
+		source_id   = "4364cdb2-dacd-429b-803e-f0f7cfde1c24" // Ubuntu 22.04
+	}
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+		serverName,
+	)
+}
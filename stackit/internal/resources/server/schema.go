@@ -0,0 +1,248 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	DefaultAvailabilityZone = "eu01-1"
+
+	SourceTypeImage    = "image"
+	SourceTypeSnapshot = "snapshot"
+	SourceTypeVolume   = "volume"
+)
+
+// Server is the schema model
+type Server struct {
+	ID                types.String      `tfsdk:"id"`
+	ProjectID         types.String      `tfsdk:"project_id"`
+	Name              types.String      `tfsdk:"name"`
+	MachineType       types.String      `tfsdk:"machine_type"`
+	AvailabilityZone  types.String      `tfsdk:"availability_zone"`
+	ImageID           types.String      `tfsdk:"image_id"`
+	BootVolume        *BootVolume       `tfsdk:"boot_volume"`
+	Networks          []types.String    `tfsdk:"networks"`
+	NetworkInterfaces types.List        `tfsdk:"network_interfaces"`
+	KeyPairName       types.String      `tfsdk:"key_pair_name"`
+	SecurityGroups    types.Set         `tfsdk:"security_groups"`
+	UserData          types.String      `tfsdk:"user_data"`
+	Labels            map[string]string `tfsdk:"labels"`
+	Status            types.String      `tfsdk:"status"`
+	Timeouts          timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// BootVolume is the schema model of the boot volume
+type BootVolume struct {
+	Size                types.Int64  `tfsdk:"size"`
+	PerformanceClass    types.String `tfsdk:"performance_class"`
+	SourceType          types.String `tfsdk:"source_type"`
+	SourceID            types.String `tfsdk:"source_id"`
+	DeleteOnTermination types.Bool   `tfsdk:"delete_on_termination"`
+}
+
+// NetworkInterface is the schema model of a network interface
+type NetworkInterface struct {
+	ID        types.String `tfsdk:"id"`
+	NetworkID types.String `tfsdk:"network_id"`
+	IPv4      types.String `tfsdk:"ipv4"`
+}
+
+var networkInterfaceType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":         types.StringType,
+		"network_id": types.StringType,
+		"ipv4":       types.StringType,
+	},
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages servers. Additional volumes are attached with `stackit_volume_attachment`, public IPs with `stackit_public_ip`.\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Specifies the server name.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"machine_type": schema.StringAttribute{
+				Description: "Specifies the flavor of the server, e.g. `g1.1`. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"availability_zone": schema.StringAttribute{
+				Description: fmt.Sprintf("Specifies the availability zone. Default is `%s`. Changing this value requires the resource to be recreated.", DefaultAvailabilityZone),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image_id": schema.StringAttribute{
+				Description: "Specifies the UUID of the image the server boots from, using an ephemeral boot disk. Either `image_id` or `boot_volume` must be set. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("boot_volume")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"boot_volume": schema.SingleNestedAttribute{
+				Description: "Specifies the volume the server boots from. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"size": schema.Int64Attribute{
+						Description: "Specifies the size in GB. Defaults to the size of the source.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"performance_class": schema.StringAttribute{
+						Description: "Specifies the performance class, e.g. `storage_premium_perf1`.",
+						Optional:    true,
+					},
+					"source_type": schema.StringAttribute{
+						Description: fmt.Sprintf("Specifies the source type, one of `%s`, `%s` or `%s`.", SourceTypeImage, SourceTypeSnapshot, SourceTypeVolume),
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(SourceTypeImage, SourceTypeSnapshot, SourceTypeVolume),
+						},
+					},
+					"source_id": schema.StringAttribute{
+						Description: "Specifies the source UUID.",
+						Required:    true,
+						Validators: []validator.String{
+							validate.UUID(),
+						},
+					},
+					"delete_on_termination": schema.BoolAttribute{
+						Description: "Specifies if the volume is deleted with the server. `true` by default.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"networks": schema.ListAttribute{
+				Description: "Specifies the UUIDs of the networks the server is connected to. The first network is used for the primary interface. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_interfaces": schema.ListNestedAttribute{
+				Description: "The network interfaces of the server, in the order of `networks`.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The network interface UUID.",
+							Computed:    true,
+						},
+						"network_id": schema.StringAttribute{
+							Description: "The network UUID.",
+							Computed:    true,
+						},
+						"ipv4": schema.StringAttribute{
+							Description: "The private IPv4 address.",
+							Computed:    true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_pair_name": schema.StringAttribute{
+				Description: "Specifies the name of the `stackit_key_pair` installed on the server. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_groups": schema.SetAttribute{
+				Description: "Specifies the names of the security groups applied to the server. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_data": schema.StringAttribute{
+				Description: "Specifies user data (e.g. cloud-init) passed to the server in plain text, it's encoded by the provider. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "Specifies labels of the server.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the server.",
+				Computed:    true,
+			},
+			"timeouts": common.Timeouts(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package accesstoken
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iam"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AccessToken
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.CreateAccessToken(ctx, plan.ProjectID.ValueString(), plan.ServiceAccountEmail.ValueString(), plan.TTLDays.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("failed creating service account access token", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(token.ID)
+	plan.Token = types.StringValue(token.Token)
+	plan.ValidUntil = types.StringValue(token.ValidUntil)
+	plan.Active = types.BoolValue(token.Active)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AccessToken
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.GetAccessToken(ctx, state.ProjectID.ValueString(), state.ServiceAccountEmail.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if iam.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading service account access token", err.Error())
+		return
+	}
+
+	// the token can't be read again and is kept from the state
+	if state.TTLDays.IsNull() {
+		state.TTLDays = ttlDays(token.CreatedAt, token.ValidUntil)
+	}
+	state.ValidUntil = types.StringValue(token.ValidUntil)
+	state.Active = types.BoolValue(token.Active)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all attributes require replacement
+	var plan AccessToken
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AccessToken
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteAccessToken(ctx, state.ProjectID.ValueString(), state.ServiceAccountEmail.ValueString(), state.ID.ValueString()); err != nil && !iam.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed deleting service account access token", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportParts returns the parts of the import identifier `project_id,service_account_email,id`
+func ImportParts() []common.ImportPart {
+	return []common.ImportPart{
+		common.ImportProjectID(),
+		common.ImportString("service_account_email"),
+		common.ImportUUID("id"),
+	}
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// the TTL isn't returned by the API, it's derived from the validity when the token is read
+	common.ImportState(ctx, req, resp, ImportParts()...)
+}
+
+// ttlDays returns the days between creation and expiry of a token, or null if they can't be parsed
+func ttlDays(createdAt, validUntil string) types.Int64 {
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return types.Int64Null()
+	}
+	expires, err := time.Parse(time.RFC3339, validUntil)
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(math.Round(expires.Sub(created).Hours() / 24)))
+}
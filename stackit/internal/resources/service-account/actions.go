@@ -0,0 +1,113 @@
+package serviceaccount
+
+import (
+	"context"
+	"strings"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iam"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ServiceAccount
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sa, err := r.client.CreateServiceAccount(ctx, plan.ProjectID.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed creating service account", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(sa.Email)
+	plan.Email = types.StringValue(sa.Email)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ServiceAccount
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sa, err := r.client.GetServiceAccount(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if iam.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading service account", err.Error())
+		return
+	}
+
+	state.Email = types.StringValue(sa.Email)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all attributes require replacement
+	var plan ServiceAccount
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ServiceAccount
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteServiceAccount(ctx, state.ProjectID.ValueString(), state.ID.ValueString()); err != nil && !iam.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed deleting service account", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportParts returns the parts of the import identifier `project_id,email`
+func ImportParts() []common.ImportPart {
+	return []common.ImportPart{
+		common.ImportProjectID(),
+		common.ImportString("email", "email", "id"),
+	}
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, ok := common.ImportState(ctx, req, resp, ImportParts()...)
+	if !ok {
+		return
+	}
+
+	// the name isn't returned by the API, it's derived from the email
+	sa, err := r.client.GetServiceAccount(ctx, id.Get("project_id"), id.Get("email"))
+	if err != nil {
+		resp.Diagnostics.AddError("failed importing service account", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), nameFromEmail(sa.Email))...)
+}
+
+// nameFromEmail returns the name a service account was created with, emails have the format `<name>-<suffix>@...`
+func nameFromEmail(email string) string {
+	local, _, _ := strings.Cut(email, "@")
+	if i := strings.LastIndex(local, "-"); i > 0 {
+		return local[:i]
+	}
+	return local
+}
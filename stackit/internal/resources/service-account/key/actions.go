@@ -0,0 +1,123 @@
+package key
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iam"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Key
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, raw, err := r.client.CreateKey(ctx, plan.ProjectID.ValueString(), plan.ServiceAccountEmail.ValueString(), iam.CreateKeyRequest{
+		PublicKey:  plan.PublicKey.ValueString(),
+		ValidUntil: plan.ValidUntil.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed creating service account key", err.Error())
+		return
+	}
+
+	// the response is the key file the key flow consumes
+	plan.KeyJSON = types.StringValue(string(raw))
+	plan.ID = types.StringValue(key.ID)
+	plan.Active = types.BoolValue(key.Active)
+	configured := plan.ValidUntil
+	plan.ValidUntil = validUntil(plan.ValidUntil, key.ValidUntil)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	// the key exists and is kept in the state, so it's replaced by the next apply
+	if !configured.IsUnknown() && !plan.ValidUntil.Equal(configured) {
+		resp.Diagnostics.AddError("unexpected service account key expiry",
+			fmt.Sprintf("the API returned `valid_until` %q for the configured %q", key.ValidUntil, configured.ValueString()))
+	}
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Key
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.GetKey(ctx, state.ProjectID.ValueString(), state.ServiceAccountEmail.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if iam.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading service account key", err.Error())
+		return
+	}
+
+	state.Active = types.BoolValue(key.Active)
+	state.ValidUntil = validUntil(state.ValidUntil, key.ValidUntil)
+	// the key file can't be read again and is kept from the state, imported keys don't have one
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all attributes require replacement
+	var plan Key
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Key
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteKey(ctx, state.ProjectID.ValueString(), state.ServiceAccountEmail.ValueString(), state.ID.ValueString()); err != nil && !iam.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed deleting service account key", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportParts returns the parts of the import identifier `project_id,service_account_email,id`
+func ImportParts() []common.ImportPart {
+	return []common.ImportPart{
+		common.ImportProjectID(),
+		common.ImportString("service_account_email"),
+		common.ImportUUID("id"),
+	}
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, ImportParts()...)
+}
+
+// validUntil keeps the current value if the API returns the same time in a different format
+// fractional seconds are ignored, as the API doesn't keep them
+func validUntil(current types.String, remote string) types.String {
+	if remote == "" {
+		return types.StringNull()
+	}
+	a, errA := time.Parse(time.RFC3339, current.ValueString())
+	b, errB := time.Parse(time.RFC3339, remote)
+	if errA == nil && errB == nil && a.Truncate(time.Second).Equal(b.Truncate(time.Second)) {
+		return current
+	}
+	return types.StringValue(remote)
+}
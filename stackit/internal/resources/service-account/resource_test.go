@@ -0,0 +1,98 @@
+package serviceaccount_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_ServiceAccount(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "acc-" + acctest.RandStringFromCharSet(7, acctest.CharSetAlpha)
+	projectID := common.GetAcceptanceTestsProjectID()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(projectID, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_service_account.example", "project_id", projectID),
+					resource.TestCheckResourceAttr("stackit_service_account.example", "name", name),
+					resource.TestCheckResourceAttrSet("stackit_service_account.example", "email"),
+					resource.TestCheckResourceAttrPair("stackit_service_account_key.example", "service_account_email", "stackit_service_account.example", "email"),
+					resource.TestCheckResourceAttrSet("stackit_service_account_key.example", "key_json"),
+					resource.TestCheckResourceAttr("stackit_service_account_key.example", "active", "true"),
+					resource.TestCheckResourceAttr("stackit_service_account_access_token.example", "ttl_days", "1"),
+					resource.TestCheckResourceAttrSet("stackit_service_account_access_token.example", "token"),
+					resource.TestCheckResourceAttrSet("stackit_service_account_access_token.example", "valid_until"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_service_account.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_service_account.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_service_account.example")
+					}
+					return fmt.Sprintf("%s,%s", projectID, r.Primary.ID), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName: "stackit_service_account_key.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_service_account_key.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_service_account_key.example")
+					}
+					return fmt.Sprintf("%s,%s,%s", projectID, r.Primary.Attributes["service_account_email"], r.Primary.ID), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_json"},
+			},
+		},
+	})
+}
+
+func config(projectID, name string) string {
+	return fmt.Sprintf(`
+resource "stackit_service_account" "example" {
+	project_id = "%s"
+	name       = "%s"
+}
+
+resource "stackit_service_account_key" "example" {
+	project_id            = stackit_service_account.example.project_id
+	service_account_email = stackit_service_account.example.email
+}
+
+resource "stackit_service_account_access_token" "example" {
+	project_id            = stackit_service_account.example.project_id
+	service_account_email = stackit_service_account.example.email
+	ttl_days              = 1
+}
+	  `,
+		projectID,
+		name,
+	)
+}
@@ -0,0 +1,148 @@
+package serviceenablement
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	serviceenablement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-enablement/v1"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/enablement"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ServiceEnablement
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, 10*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	serviceID := plan.ServiceID.ValueString()
+	if err := enablement.For(r.client).EnableService(ctx, projectID, serviceID, timeout); err != nil {
+		resp.Diagnostics.AddError("failed to enable service", err.Error())
+		return
+	}
+
+	res, err := r.client.ServiceEnablement.GetService(ctx, projectID, serviceID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200.State"); agg != nil {
+		resp.Diagnostics.AddError("failed to read service enablement", agg.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(projectID + "," + serviceID)
+	plan.State = types.StringValue(string(*res.JSON200.State))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ServiceEnablement
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	serviceID := state.ServiceID.ValueString()
+	res, err := r.client.ServiceEnablement.GetService(ctx, projectID, serviceID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200.State"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read service enablement", agg.Error())
+		return
+	}
+
+	// a service that was disabled outside of terraform needs to be enabled again
+	if *res.JSON200.State != serviceenablement.ENABLED {
+		enablement.For(r.client).Forget(projectID, serviceID)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(projectID + "," + serviceID)
+	state.State = types.StringValue(string(*res.JSON200.State))
+	if state.DisableOnDestroy.IsNull() {
+		state.DisableOnDestroy = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ServiceEnablement
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only disable_on_destroy and timeouts can change in place
+	plan.ID = state.ID
+	plan.State = state.State
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ServiceEnablement
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.DisableOnDestroy.ValueBool() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	timeout, d := state.Timeouts.Delete(ctx, 10*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	serviceID := state.ServiceID.ValueString()
+	c := r.client.ServiceEnablement
+
+	// the service may have been enabled again by another resource, so the cache entry is dropped in any case
+	defer enablement.For(r.client).Forget(projectID, serviceID)
+
+	res, err := c.DisableService(ctx, projectID, serviceID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to disable service", agg.Error())
+		return
+	}
+
+	process := res.WaitHandler(ctx, c, projectID, serviceID).SetTimeout(timeout)
+	if _, err := process.WaitWithContext(ctx); err != nil {
+		resp.Diagnostics.AddError("failed to verify service was disabled", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, ok := common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportString("service_id")); !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
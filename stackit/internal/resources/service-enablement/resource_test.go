@@ -0,0 +1,59 @@
+package serviceenablement_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_ServiceEnablement(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_service_enablement.example", "project_id", common.GetAcceptanceTestsProjectID()),
+					resource.TestCheckResourceAttr("stackit_service_enablement.example", "service_id", "cloud.stackit.ske"),
+					resource.TestCheckResourceAttr("stackit_service_enablement.example", "id", common.GetAcceptanceTestsProjectID()+",cloud.stackit.ske"),
+					resource.TestCheckResourceAttr("stackit_service_enablement.example", "state", "ENABLED"),
+					resource.TestCheckResourceAttr("stackit_service_enablement.example", "disable_on_destroy", "false"),
+				),
+			},
+			// test import
+			{
+				ResourceName:            "stackit_service_enablement.example",
+				ImportStateId:           common.GetAcceptanceTestsProjectID() + ",cloud.stackit.ske",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func config() string {
+	return fmt.Sprintf(`
+resource "stackit_service_enablement" "example" {
+	project_id = "%s"
+	service_id = "cloud.stackit.ske"
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+	)
+}
@@ -0,0 +1,85 @@
+package serviceenablement
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ServiceEnablement is the schema model
+type ServiceEnablement struct {
+	ID               types.String   `tfsdk:"id"`
+	ProjectID        types.String   `tfsdk:"project_id"`
+	ServiceID        types.String   `tfsdk:"service_id"`
+	State            types.String   `tfsdk:"state"`
+	DisableOnDestroy types.Bool     `tfsdk:"disable_on_destroy"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("This resource enables a STACKIT service in a project\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the ID of the enablement in the format `project_id,service_id`",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"project_id": schema.StringAttribute{
+				Description: "the project ID that the service will be enabled in",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"service_id": schema.StringAttribute{
+				Description: "the ID of the service to enable, e.g. `cloud.stackit.ske`",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"state": schema.StringAttribute{
+				Description: "the state of the service in the project",
+				Computed:    true,
+			},
+
+			"disable_on_destroy": schema.BoolAttribute{
+				Description: "if set to `true`, the service is disabled when the resource is destroyed. By default, the service stays enabled and is only removed from the state",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+
+			"timeouts": common.Timeouts(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
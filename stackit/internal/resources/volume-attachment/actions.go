@@ -0,0 +1,113 @@
+package volumeattachment
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Attachment
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(plan.ProjectID.ValueString())
+	serverID := uuid.MustParse(plan.ServerID.ValueString())
+	volumeID := uuid.MustParse(plan.VolumeID.ValueString())
+	res, err := r.client.IAAS.Server.V1AddVolumeToServer(ctx, projectID, serverID, volumeID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		resp.Diagnostics.AddError("failed attaching volume", agg.Error())
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, 5*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	process := res.WaitHandler(ctx, r.client.IAAS.Volume, projectID, volumeID).SetTimeout(timeout)
+	if _, err := process.WaitWithContext(ctx); err != nil {
+		resp.Diagnostics.AddError("failed validating volume attachment", err.Error())
+		return
+	}
+
+	plan.ID = plan.VolumeID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Attachment
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(state.ProjectID.ValueString())
+	serverID := uuid.MustParse(state.ServerID.ValueString())
+	volumeID := uuid.MustParse(state.VolumeID.ValueString())
+	res, err := r.client.IAAS.Server.V1GetAttachedVolume(ctx, projectID, serverID, volumeID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading volume attachment", agg.Error())
+		return
+	}
+
+	state.ID = state.VolumeID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Attachment
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(state.ProjectID.ValueString())
+	serverID := uuid.MustParse(state.ServerID.ValueString())
+	volumeID := uuid.MustParse(state.VolumeID.ValueString())
+	res, err := r.client.IAAS.Server.V1RemoveVolumeFromServer(ctx, projectID, serverID, volumeID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed detaching volume", agg.Error())
+		return
+	}
+
+	timeout, d := state.Timeouts.Delete(ctx, 5*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	process := res.WaitHandler(ctx, r.client.IAAS.Volume, projectID, volumeID).SetTimeout(timeout)
+	if _, err := process.WaitWithContext(ctx); err != nil {
+		resp.Diagnostics.AddError("failed to verify volume detachment", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("server_id"), common.ImportUUID("volume_id"))
+}
@@ -0,0 +1,101 @@
+package volumeattachment_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_VolumeAttachment(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("stackit_volume_attachment.example", "server_id", "stackit_server.example", "id"),
+					resource.TestCheckResourceAttrPair("stackit_volume_attachment.example", "volume_id", "stackit_volume.example", "id"),
+					resource.TestCheckResourceAttrPair("stackit_volume_attachment.example", "id", "stackit_volume.example", "id"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_volume_attachment.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_volume_attachment.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_volume_attachment.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+
+					return fmt.Sprintf("%s,%s,%s", common.GetAcceptanceTestsProjectID(), r.Primary.Attributes["server_id"], id), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(name string) string {
+	return fmt.Sprintf(`
+resource "stackit_network" "example" {
+	project_id  = "%s"
+	name        = "%s"
+	nameservers = ["8.8.8.8"]
+}
+
+resource "stackit_server" "example" {
+	project_id   = stackit_network.example.project_id
+	name         = "%s"
+	machine_type = "g1.1"
+	networks     = [stackit_network.example.id]
+
+	boot_volume = {
+		size        = 32
+		source_type = "image"
+		source_id   = "4364cdb2-dacd-429b-803e-f0f7cfde1c24" // Ubuntu 22.04
+	}
+}
+
+resource "stackit_volume" "example" {
+	project_id = stackit_network.example.project_id
+	name       = "%s"
+	size       = 16
+}
+
+resource "stackit_volume_attachment" "example" {
+	project_id = stackit_server.example.project_id
+	server_id  = stackit_server.example.id
+	volume_id  = stackit_volume.example.id
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+		name,
+		name,
+	)
+}
@@ -0,0 +1,230 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	iaas_volume "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/volume"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/wait"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Volume
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	az := DefaultAvailabilityZone
+	if !plan.AvailabilityZone.IsUnknown() && !plan.AvailabilityZone.IsNull() {
+		az = plan.AvailabilityZone.ValueString()
+	}
+	body := iaas_volume.V1CreateVolumeJSONRequestBody{
+		Name:             plan.Name.ValueStringPointer(),
+		Description:      plan.Description.ValueStringPointer(),
+		AvailabilityZone: az,
+		Labels:           common.FromLabels(plan.Labels),
+	}
+	if !plan.Size.IsUnknown() && !plan.Size.IsNull() {
+		size := int(plan.Size.ValueInt64())
+		body.Size = &size
+	}
+	if !plan.PerformanceClass.IsUnknown() && !plan.PerformanceClass.IsNull() {
+		body.PerformanceClass = plan.PerformanceClass.ValueStringPointer()
+	}
+	if plan.Source != nil {
+		body.Source = &iaas_volume.V1VolumeSource{
+			Type: plan.Source.Type.ValueString(),
+			ID:   uuid.MustParse(plan.Source.ID.ValueString()),
+		}
+	}
+
+	projectID := uuid.MustParse(plan.ProjectID.ValueString())
+	res, err := r.client.IAAS.Volume.V1CreateVolume(ctx, projectID, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed creating volume", agg.Error())
+		return
+	}
+
+	volumeID := res.JSON201.ID
+	plan.ID = types.StringValue(volumeID.String())
+
+	// save the ID, so the volume isn't lost if waiting fails
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), plan.ProjectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, 10*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	process := res.WaitHandler(ctx, r.client.IAAS.Volume, projectID, volumeID).SetTimeout(timeout)
+	wr, err := process.WaitWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed validating volume creation", err.Error())
+		return
+	}
+
+	v, ok := wr.(iaas_volume.V1Volume)
+	if !ok {
+		resp.Diagnostics.AddError("failed wait result conversion", "result is not of iaas_volume.V1Volume")
+		return
+	}
+
+	transform(&plan, v)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func transform(state *Volume, v iaas_volume.V1Volume) {
+	state.ID = types.StringValue(v.ID.String())
+	state.Name = types.StringNull()
+	if v.Name != nil && *v.Name != "" {
+		state.Name = types.StringPointerValue(v.Name)
+	}
+	state.AvailabilityZone = types.StringValue(v.AvailabilityZone)
+	state.PerformanceClass = types.StringPointerValue(v.PerformanceClass)
+	state.Labels = common.ToLabels(v.Labels)
+	state.Description = types.StringNull()
+	if v.Description != nil && *v.Description != "" {
+		state.Description = types.StringPointerValue(v.Description)
+	}
+	if v.Size != nil {
+		state.Size = types.Int64Value(int64(*v.Size))
+	}
+	state.ServerID = types.StringNull()
+	if v.ServerID != nil {
+		state.ServerID = types.StringValue(v.ServerID.String())
+	}
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Volume
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(state.ProjectID.ValueString())
+	volumeID := uuid.MustParse(state.ID.ValueString())
+	res, err := r.client.IAAS.Volume.V1GetVolume(ctx, projectID, volumeID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading volume", agg.Error())
+		return
+	}
+
+	transform(&state, *res.JSON200)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Volume
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	description := plan.Description.ValueString()
+	projectID := uuid.MustParse(plan.ProjectID.ValueString())
+	volumeID := uuid.MustParse(plan.ID.ValueString())
+	res, err := r.client.IAAS.Volume.V1UpdateVolume(ctx, projectID, volumeID, iaas_volume.V1UpdateVolumeJSONRequestBody{
+		Name:        &name,
+		Description: &description,
+		Labels:      common.FromLabels(plan.Labels),
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		resp.Diagnostics.AddError("failed updating volume", agg.Error())
+		return
+	}
+
+	// shrinking is handled by replacing the volume, see requiresReplaceOnShrink
+	if !plan.Size.IsUnknown() && !plan.Size.IsNull() && plan.Size.ValueInt64() > state.Size.ValueInt64() {
+		timeout, d := plan.Timeouts.Update(ctx, 10*time.Minute)
+		if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.resize(ctx, projectID, volumeID, plan.Size.ValueInt64(), timeout); err != nil {
+			resp.Diagnostics.AddError("failed resizing volume", err.Error())
+			return
+		}
+	}
+
+	plan.ServerID = state.ServerID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// resize extends the volume and waits until the new size is reported
+func (r Resource) resize(ctx context.Context, projectID, volumeID uuid.UUID, size int64, timeout time.Duration) error {
+	res, err := r.client.IAAS.Volume.V1ResizeVolume(ctx, projectID, volumeID, iaas_volume.V1ResizeVolumeJSONRequestBody{
+		Size: int(size),
+	})
+	if agg := validate.Response(res, err); agg != nil {
+		return agg
+	}
+
+	return wait.Until(ctx, fmt.Sprintf("volume %s in project %s to be resized to %dGB", volumeID, projectID, size), timeout, func(ctx context.Context) (bool, error) {
+		res, err := r.client.IAAS.Volume.V1GetVolume(ctx, projectID, volumeID)
+		if agg := validate.Response(res, err, "JSON200"); agg != nil {
+			return false, wait.Retry(agg)
+		}
+		return res.JSON200.Size != nil && int64(*res.JSON200.Size) == size, nil
+	})
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Volume
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := uuid.MustParse(state.ProjectID.ValueString())
+	volumeID := uuid.MustParse(state.ID.ValueString())
+	res, err := r.client.IAAS.Volume.V1DeleteVolume(ctx, projectID, volumeID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed deleting volume", agg.Error())
+		return
+	}
+
+	timeout, d := state.Timeouts.Delete(ctx, 10*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	process := res.WaitHandler(ctx, r.client.IAAS.Volume, projectID, volumeID).SetTimeout(timeout)
+	if _, err := process.WaitWithContext(ctx); err != nil {
+		resp.Diagnostics.AddError("failed to verify volume deletion", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	common.ImportState(ctx, req, resp, common.ImportProjectID(), common.ImportUUID("id"))
+}
@@ -0,0 +1,101 @@
+package volume_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_Volume(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	id := ""
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name, 16),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_volume.example", "name", name),
+					resource.TestCheckResourceAttr("stackit_volume.example", "project_id", common.GetAcceptanceTestsProjectID()),
+					resource.TestCheckResourceAttr("stackit_volume.example", "size", "16"),
+					resource.TestCheckResourceAttr("stackit_volume.example", "availability_zone", "eu01-1"),
+					resource.TestCheckResourceAttr("stackit_volume.example", "labels.env", "test"),
+					resource.TestCheckResourceAttrSet("stackit_volume.example", "performance_class"),
+					resource.TestCheckResourceAttrSet("stackit_volume.example", "id"),
+					resource.TestCheckResourceAttrWith("stackit_volume.example", "id", func(v string) error {
+						id = v
+						return nil
+					}),
+				),
+			},
+			// check the volume is extended in place
+			{
+				Config: config(name, 32),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_volume.example", "size", "32"),
+					resource.TestCheckResourceAttrWith("stackit_volume.example", "id", func(v string) error {
+						if v != id {
+							return fmt.Errorf("volume was recreated, id changed from %s to %s", id, v)
+						}
+						return nil
+					}),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_volume.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_volume.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_volume.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+
+					return fmt.Sprintf("%s,%s", common.GetAcceptanceTestsProjectID(), id), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func config(name string, size int) string {
+	return fmt.Sprintf(`
+resource "stackit_volume" "example" {
+	project_id = "%s"
+	name       = "%s"
+	size       = %d
+	labels = {
+		env = "test"
+	}
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+		size,
+	)
+}
@@ -0,0 +1,170 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	DefaultAvailabilityZone = "eu01-1"
+
+	SourceTypeImage    = "image"
+	SourceTypeSnapshot = "snapshot"
+	SourceTypeVolume   = "volume"
+	SourceTypeBackup   = "backup"
+)
+
+// Volume is the schema model
+type Volume struct {
+	ID               types.String      `tfsdk:"id"`
+	ProjectID        types.String      `tfsdk:"project_id"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	Size             types.Int64       `tfsdk:"size"`
+	AvailabilityZone types.String      `tfsdk:"availability_zone"`
+	PerformanceClass types.String      `tfsdk:"performance_class"`
+	Source           *Source           `tfsdk:"source"`
+	ServerID         types.String      `tfsdk:"server_id"`
+	Labels           map[string]string `tfsdk:"labels"`
+	Timeouts         timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// Source is the schema model of the volume source
+type Source struct {
+	Type types.String `tfsdk:"type"`
+	ID   types.String `tfsdk:"id"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages block storage volumes, which can be attached to servers with `stackit_volume_attachment`\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Specifies the volume name.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Specifies the description of the volume.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(127),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Description: "Specifies the size in GB. Required unless `source` is set, in which case it defaults to the size of the source. Volumes are resized in place, shrinking one requires the resource to be recreated.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtLeastOneOf(path.MatchRoot("source")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplaceIf(requiresReplaceOnShrink, "Shrinking the volume requires the resource to be recreated.", "Shrinking the volume requires the resource to be recreated."),
+				},
+			},
+			"availability_zone": schema.StringAttribute{
+				Description: fmt.Sprintf("Specifies the availability zone. Default is `%s`. Changing this value requires the resource to be recreated.", DefaultAvailabilityZone),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"performance_class": schema.StringAttribute{
+				Description: "Specifies the performance class, e.g. `storage_premium_perf1`. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.SingleNestedAttribute{
+				Description: "Specifies the source the volume is created from. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: fmt.Sprintf("Specifies the source type, one of `%s`, `%s`, `%s` or `%s`.", SourceTypeImage, SourceTypeSnapshot, SourceTypeVolume, SourceTypeBackup),
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(SourceTypeImage, SourceTypeSnapshot, SourceTypeVolume, SourceTypeBackup),
+						},
+					},
+					"id": schema.StringAttribute{
+						Description: "Specifies the source UUID.",
+						Required:    true,
+						Validators: []validator.String{
+							validate.UUID(),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Description: "The UUID of the server the volume is attached to.",
+				Computed:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Specifies labels of the volume.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"timeouts": common.Timeouts(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// requiresReplaceOnShrink replaces the volume if the planned size is smaller than the current one
+// volumes can only be extended in place
+func requiresReplaceOnShrink(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	resp.RequiresReplace = req.PlanValue.ValueInt64() < req.StateValue.ValueInt64()
+}
@@ -0,0 +1,170 @@
+// Package wait polls resources until they're ready
+// it replaces fixed sleeps before the client's wait handlers, for APIs that are eventually consistent
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// CheckFunc reports if the awaited condition is met
+// returning an error aborts waiting, unless it's wrapped with Retry
+type CheckFunc func(ctx context.Context) (done bool, err error)
+
+// Backoff configures the polling interval
+type Backoff struct {
+	// Initial is the delay before the second poll, the first poll happens immediately
+	Initial time.Duration
+	// Max caps the delay between polls
+	Max time.Duration
+	// Multiplier grows the delay after every poll
+	Multiplier float64
+	// Jitter randomizes every delay by up to the given fraction, so parallel waits don't poll in lockstep
+	Jitter float64
+}
+
+// DefaultBackoff polls after 1s, 2s, 4s, ... up to every 30s
+var DefaultBackoff = Backoff{
+	Initial:    time.Second,
+	Max:        30 * time.Second,
+	Multiplier: 2,
+	Jitter:     0.2,
+}
+
+// ErrTimeout is returned when the condition isn't met within the timeout
+var ErrTimeout = errors.New("timed out")
+
+type retryError struct {
+	err error
+}
+
+func (e retryError) Error() string { return e.err.Error() }
+func (e retryError) Unwrap() error { return e.err }
+
+// Retry marks an error as transient, polling continues
+// the last transient error is reported if the timeout is reached
+func Retry(err error) error {
+	if err == nil {
+		return nil
+	}
+	return retryError{err: err}
+}
+
+// NotFound reports if the response is a 404
+// eventually consistent APIs return it for a while after a resource was created
+func NotFound(res interface{}) bool {
+	return validate.StatusEquals(res, http.StatusNotFound)
+}
+
+// Until polls check with the default backoff until it's done, fails or the timeout is reached
+func Until(ctx context.Context, what string, timeout time.Duration, check CheckFunc) error {
+	return DefaultBackoff.Until(ctx, what, timeout, check)
+}
+
+// Until polls check until it's done, fails or the timeout is reached
+// what describes the awaited condition in logs and errors
+func (b Backoff) Until(ctx context.Context, what string, timeout time.Duration, check CheckFunc) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	var last error
+	for attempt := 1; ; attempt++ {
+		done, err := check(ctx)
+		if done {
+			tflog.Debug(ctx, fmt.Sprintf("done waiting for %s", what), map[string]interface{}{
+				"attempts": attempt,
+				"elapsed":  time.Since(start).String(),
+			})
+			return nil
+		}
+
+		var retry retryError
+		if err != nil && !errors.As(err, &retry) {
+			return fmt.Errorf("failed waiting for %s: %w", what, err)
+		}
+		last = err
+
+		delay := b.delay(attempt, rand.Float64)
+		tflog.Debug(ctx, fmt.Sprintf("waiting for %s", what), map[string]interface{}{
+			"attempt":   attempt,
+			"elapsed":   time.Since(start).String(),
+			"next_poll": delay.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			if last != nil {
+				return fmt.Errorf("%w after %s waiting for %s: %w", ErrTimeout, time.Since(start).Round(time.Second), what, last)
+			}
+			return fmt.Errorf("%w after %s waiting for %s", ErrTimeout, time.Since(start).Round(time.Second), what)
+		case <-time.After(delay):
+		}
+	}
+}
+
+// StatusFunc returns the current status of a resource
+type StatusFunc func(ctx context.Context) (string, error)
+
+// Transition waits with the default backoff until an asynchronous operation on a ready resource completed
+func Transition(ctx context.Context, what string, timeout, grace time.Duration, status StatusFunc, ready string, failed ...string) error {
+	return DefaultBackoff.Transition(ctx, what, timeout, grace, status, ready, failed...)
+}
+
+// Transition waits until an asynchronous operation on a ready resource completed
+// the resource keeps reporting the ready status until the operation starts, so the status first has to leave it
+// if it doesn't within grace, the operation is considered complete already
+// like with Until, errors returned by status abort waiting unless they're wrapped with Retry, and so does reaching a status in failed
+func (b Backoff) Transition(ctx context.Context, what string, timeout, grace time.Duration, status StatusFunc, ready string, failed ...string) error {
+	check := func(ctx context.Context) (string, error) {
+		s, err := status(ctx)
+		if err != nil {
+			return "", err
+		}
+		if slices.Contains(failed, s) {
+			return s, fmt.Errorf("reached status %s", s)
+		}
+		return s, nil
+	}
+
+	if grace > timeout {
+		grace = timeout
+	}
+	start := time.Now()
+	err := b.Until(ctx, what+" to start", grace, func(ctx context.Context) (bool, error) {
+		s, err := check(ctx)
+		return err == nil && s != ready, err
+	})
+	if err != nil && !errors.Is(err, ErrTimeout) {
+		return err
+	}
+
+	return b.Until(ctx, what, timeout-time.Since(start), func(ctx context.Context) (bool, error) {
+		s, err := check(ctx)
+		return err == nil && s == ready, err
+	})
+}
+
+// delay returns the time to wait after the given attempt
+// rnd returns a value in [0, 1) and is injected for tests
+func (b Backoff) delay(attempt int, rnd func() float64) time.Duration {
+	d := float64(b.Initial)
+	for i := 1; i < attempt && d < float64(b.Max); i++ {
+		d *= b.Multiplier
+	}
+	if d > float64(b.Max) {
+		d = float64(b.Max)
+	}
+	if b.Jitter > 0 {
+		d += d * b.Jitter * (2*rnd() - 1)
+	}
+	return time.Duration(d)
+}
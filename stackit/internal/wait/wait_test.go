@@ -0,0 +1,156 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+func TestDelay(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	b := Backoff{Initial: time.Second, Max: 10 * time.Second, Multiplier: 2, Jitter: 0.5}
+	tests := []struct {
+		attempt int
+		rnd     float64
+		want    time.Duration
+	}{
+		{attempt: 1, rnd: 0.5, want: time.Second},
+		{attempt: 2, rnd: 0.5, want: 2 * time.Second},
+		{attempt: 4, rnd: 0.5, want: 8 * time.Second},
+		{attempt: 5, rnd: 0.5, want: 10 * time.Second},
+		{attempt: 100, rnd: 0.5, want: 10 * time.Second},
+		{attempt: 1, rnd: 0, want: 500 * time.Millisecond},
+		{attempt: 2, rnd: 1, want: 3 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := b.delay(tt.attempt, func() float64 { return tt.rnd }); got != tt.want {
+			t.Errorf("delay(%d, %v) = %s, want %s", tt.attempt, tt.rnd, got, tt.want)
+		}
+	}
+}
+
+func TestUntil(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	b := Backoff{Initial: time.Millisecond, Max: 5 * time.Millisecond, Multiplier: 2}
+	errFailed := errors.New("failed")
+	errNotYet := errors.New("not found")
+
+	t.Run("done", func(t *testing.T) {
+		calls := 0
+		err := b.Until(ctx, "test", time.Second, func(context.Context) (bool, error) {
+			calls++
+			return calls == 3, nil
+		})
+		if err != nil || calls != 3 {
+			t.Errorf("Until() = %v after %d calls, want nil after 3", err, calls)
+		}
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		calls := 0
+		err := b.Until(ctx, "test", time.Second, func(context.Context) (bool, error) {
+			calls++
+			if calls < 3 {
+				return false, Retry(errNotYet)
+			}
+			return true, nil
+		})
+		if err != nil || calls != 3 {
+			t.Errorf("Until() = %v after %d calls, want nil after 3", err, calls)
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		calls := 0
+		err := b.Until(ctx, "test", time.Second, func(context.Context) (bool, error) {
+			calls++
+			return false, errFailed
+		})
+		if !errors.Is(err, errFailed) || calls != 1 {
+			t.Errorf("Until() = %v after %d calls, want %v after 1", err, calls, errFailed)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		err := b.Until(ctx, "test", 20*time.Millisecond, func(context.Context) (bool, error) {
+			return false, Retry(errNotYet)
+		})
+		if !errors.Is(err, ErrTimeout) || !errors.Is(err, errNotYet) {
+			t.Errorf("Until() = %v, want timeout wrapping %v", err, errNotYet)
+		}
+	})
+}
+
+func TestTransition(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	b := Backoff{Initial: time.Millisecond, Max: 5 * time.Millisecond, Multiplier: 2}
+	statuses := func(s ...string) StatusFunc {
+		calls := 0
+		return func(context.Context) (string, error) {
+			if calls >= len(s) {
+				return s[len(s)-1], nil
+			}
+			calls++
+			return s[calls-1], nil
+		}
+	}
+
+	t.Run("stale ready", func(t *testing.T) {
+		status := statuses("READY", "READY", "PROCESSING", "PROCESSING", "READY")
+		if err := b.Transition(ctx, "test", time.Second, time.Second, status, "READY", "FAILED"); err != nil {
+			t.Errorf("Transition() = %v, want nil", err)
+		}
+		if s, _ := status(ctx); s != "READY" {
+			t.Errorf("Transition() returned before reaching READY")
+		}
+	})
+
+	t.Run("already complete", func(t *testing.T) {
+		status := statuses("READY")
+		if err := b.Transition(ctx, "test", time.Second, 20*time.Millisecond, status, "READY", "FAILED"); err != nil {
+			t.Errorf("Transition() = %v, want nil", err)
+		}
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		status := statuses("READY", "PROCESSING", "FAILED")
+		if err := b.Transition(ctx, "test", time.Second, time.Second, status, "READY", "FAILED"); err == nil || errors.Is(err, ErrTimeout) {
+			t.Errorf("Transition() = %v, want failure", err)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		errGet := errors.New("get failed")
+		status := func(context.Context) (string, error) { return "", errGet }
+		if err := b.Transition(ctx, "test", time.Second, time.Second, status, "READY", "FAILED"); !errors.Is(err, errGet) {
+			t.Errorf("Transition() = %v, want %v", err, errGet)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		status := statuses("READY", "PROCESSING")
+		if err := b.Transition(ctx, "test", 30*time.Millisecond, 10*time.Millisecond, status, "READY", "FAILED"); !errors.Is(err, ErrTimeout) {
+			t.Errorf("Transition() = %v, want timeout", err)
+		}
+	})
+}
@@ -0,0 +1,399 @@
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Mapping describes how a resource type of this provider maps to the official provider
+type Mapping struct {
+	// To is the resource type in the official provider, empty if there's no equivalent
+	To string
+
+	// ImportID is the template of the import identifier in the official provider
+	// attributes of this provider are referenced as {name}, the region as {region}
+	// empty if the official resource can't be imported
+	ImportID string
+
+	// Attributes maps attributes to their equivalent in the official provider
+	// attributes that aren't listed have no equivalent and are reported if they're set
+	Attributes map[string]Convert
+
+	// Note is added to the report of every resource of this type
+	Note string
+}
+
+// Convert returns the attributes of the official resource for the value of an attribute
+type Convert func(v interface{}) (map[string]interface{}, error)
+
+// rename copies the value to the attribute called to
+func rename(to string) Convert {
+	return func(v interface{}) (map[string]interface{}, error) {
+		return map[string]interface{}{to: v}, nil
+	}
+}
+
+// same copies the value to the attribute of the same name
+func same(attributes map[string]Convert, names ...string) map[string]Convert {
+	for _, n := range names {
+		attributes[n] = rename(n)
+	}
+	return attributes
+}
+
+// computed skips attributes that are read from the API and don't need to be set
+func computed(attributes map[string]Convert, names ...string) map[string]Convert {
+	for _, n := range names {
+		attributes[n] = func(interface{}) (map[string]interface{}, error) { return nil, nil }
+	}
+	return attributes
+}
+
+// nest moves the value into the object attribute called to, under key
+func nest(to, key string) Convert {
+	return func(v interface{}) (map[string]interface{}, error) {
+		return map[string]interface{}{to: map[string]interface{}{key: v}}, nil
+	}
+}
+
+// renameKeys copies a list of objects to the attribute called to, renaming keys of the objects
+func renameKeys(to string, keys map[string]string) Convert {
+	return func(v interface{}) (map[string]interface{}, error) {
+		list, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a list, got %T", v)
+		}
+		out := make([]interface{}, len(list))
+		for i, item := range list {
+			obj, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("expected a list of objects, got %T", item)
+			}
+			renamed := make(map[string]interface{}, len(obj))
+			for k, val := range obj {
+				if nk, ok := keys[k]; ok {
+					k = nk
+				}
+				renamed[k] = val
+			}
+			out[i] = renamed
+		}
+		return map[string]interface{}{to: out}, nil
+	}
+}
+
+// first copies the first element of a list to the attribute called to
+func first(to string) Convert {
+	return func(v interface{}) (map[string]interface{}, error) {
+		list, ok := v.([]interface{})
+		if !ok || len(list) == 0 {
+			return nil, nil
+		}
+		if len(list) > 1 {
+			return nil, fmt.Errorf("only a single value is supported by `%s`, got %d", to, len(list))
+		}
+		return map[string]interface{}{to: list[0]}, nil
+	}
+}
+
+// unsupported reports the attribute with the given reason if it's set
+func unsupported(reason string) Convert {
+	return func(v interface{}) (map[string]interface{}, error) {
+		return nil, errors.New(reason)
+	}
+}
+
+// flavor converts a machine type like `2.4` to a flavor with 2 CPUs and 4 GB RAM
+func flavor(v interface{}) (map[string]interface{}, error) {
+	s, _ := v.(string)
+	cpu, ram, ok := strings.Cut(s, ".")
+	c, errCPU := strconv.Atoi(cpu)
+	r, errRAM := strconv.Atoi(ram)
+	if !ok || errCPU != nil || errRAM != nil {
+		return nil, fmt.Errorf("machine type %q can't be converted to a flavor, set `flavor` manually", s)
+	}
+	return map[string]interface{}{"flavor": map[string]interface{}{"cpu": c, "ram": r}}, nil
+}
+
+// joinACL converts a list of CIDRs to the comma separated `sgw_acl` parameter of data services
+func joinACL(v interface{}) (map[string]interface{}, error) {
+	list, _ := v.([]interface{})
+	cidrs := make([]string, 0, len(list))
+	for _, c := range list {
+		cidrs = append(cidrs, fmt.Sprint(c))
+	}
+	return map[string]interface{}{"parameters": map[string]interface{}{"sgw_acl": strings.Join(cidrs, ",")}}, nil
+}
+
+// argusMetrics flattens the `metrics` object into the retention attributes of observability instances
+func argusMetrics(v interface{}) (map[string]interface{}, error) {
+	m, _ := v.(map[string]interface{})
+	out := map[string]interface{}{}
+	for from, to := range map[string]string{
+		"retention_days":                 "metrics_retention_days",
+		"retention_days_5m_downsampling": "metrics_retention_days_5m_downsampling",
+		"retention_days_1h_downsampling": "metrics_retention_days_1h_downsampling",
+	} {
+		if m[from] != nil {
+			out[to] = m[from]
+		}
+	}
+	return out, nil
+}
+
+// argusGrafana reports public Grafana access, which can't be set in the official provider
+func argusGrafana(v interface{}) (map[string]interface{}, error) {
+	m, _ := v.(map[string]interface{})
+	if m["enable_public_access"] == true {
+		return nil, errors.New("public Grafana access has no equivalent")
+	}
+	return nil, nil
+}
+
+// dataServices are the data services offered by both providers under the same name
+var dataServices = []string{"logme", "mariadb", "opensearch", "rabbitmq", "redis"}
+
+// Mappings returns the mapping of every resource type of this provider
+//
+//nolint:funlen
+func Mappings() map[string]Mapping {
+	m := map[string]Mapping{
+		"stackit_project": {
+			To:         "stackit_resourcemanager_project",
+			ImportID:   "{container_id}",
+			Attributes: computed(same(map[string]Convert{}, "parent_container_id", "name", "owner_email", "labels"), "container_id"),
+		},
+		"stackit_kubernetes_project": {
+			Note: "SKE is enabled by `stackit_ske_cluster`, the project is only removed from the state",
+		},
+		"stackit_kubernetes_cluster": {
+			To:       "stackit_ske_cluster",
+			ImportID: "{project_id},{region},{name}",
+			Attributes: computed(same(map[string]Convert{
+				"kubernetes_version": rename("kubernetes_version_min"),
+				"network_id":         nest("network", "id"),
+				"node_pools": renameKeys("node_pools", map[string]string{
+					"os_version":        "os_version_min",
+					"volume_size_gb":    "volume_size",
+					"container_runtime": "cri",
+					"zones":             "availability_zones",
+				}),
+			}, "name", "project_id", "maintenance", "hibernations", "extensions"),
+				"kubernetes_project_id", "kubernetes_version_used", "status", "kube_config"),
+			Note: "the kubeconfig is managed by `stackit_ske_kubeconfig`",
+		},
+		"stackit_postgres_flex_instance": {
+			To:       "stackit_postgresflex_instance",
+			ImportID: "{project_id},{region},{id}",
+			Attributes: same(map[string]Convert{
+				"machine_type": flavor,
+			}, "name", "project_id", "acl", "backup_schedule", "replicas", "storage", "version"),
+		},
+		"stackit_postgres_flex_user": {
+			To:         "stackit_postgresflex_user",
+			ImportID:   "{project_id},{region},{instance_id},{id}",
+			Attributes: computed(same(map[string]Convert{}, "project_id", "instance_id", "username", "roles"), "password", "host", "port", "uri"),
+		},
+		"stackit_mongodb_flex_instance": {
+			To:       "stackit_mongodbflex_instance",
+			ImportID: "{project_id},{region},{id}",
+			Attributes: same(map[string]Convert{
+				"machine_type": flavor,
+				"type":         nest("options", "type"),
+			}, "name", "project_id", "acl", "backup_schedule", "replicas", "storage", "version"),
+		},
+		"stackit_mongodb_flex_user": {
+			To:         "stackit_mongodbflex_user",
+			ImportID:   "{project_id},{region},{instance_id},{id}",
+			Attributes: computed(same(map[string]Convert{}, "project_id", "instance_id", "username", "database", "roles"), "password", "host", "port", "uri"),
+		},
+		"stackit_mongodb_flex_role": {
+			Note: "custom roles aren't managed by the official provider, the role is only removed from the state",
+		},
+		"stackit_mongodb_flex_restore": {
+			Note: "a restore is a one-off operation, it's only removed from the state",
+		},
+		"stackit_elasticsearch_instance": {
+			Note: "Elasticsearch isn't offered by the official provider, consider `stackit_opensearch_instance`",
+		},
+		"stackit_elasticsearch_credential": {
+			Note: "Elasticsearch isn't offered by the official provider",
+		},
+		"stackit_postgres_instance": {
+			Note: "PostgreSQL data service instances aren't offered by the official provider, consider `stackit_postgresflex_instance`",
+		},
+		"stackit_postgres_credential": {
+			Note: "PostgreSQL data service instances aren't offered by the official provider",
+		},
+		"stackit_object_storage_project": {
+			Note: "Object Storage is enabled by the official provider when needed, the project is only removed from the state",
+		},
+		"stackit_object_storage_bucket": {
+			To:         "stackit_objectstorage_bucket",
+			ImportID:   "{project_id},{region},{name}",
+			Attributes: computed(same(map[string]Convert{}, "name", "project_id"), "object_storage_project_id", "region", "host_style_url", "path_style_url"),
+		},
+		"stackit_object_storage_credentials_group": {
+			To:         "stackit_objectstorage_credentials_group",
+			ImportID:   "{project_id},{region},{id}",
+			Attributes: computed(same(map[string]Convert{}, "name", "project_id"), "object_storage_project_id", "urn"),
+		},
+		"stackit_object_storage_credential": {
+			To:       "stackit_objectstorage_credential",
+			ImportID: "{project_id},{region},{credentials_group_id},{id}",
+			Attributes: computed(same(map[string]Convert{
+				"expiry": rename("expiration_timestamp"),
+			}, "project_id", "credentials_group_id"), "object_storage_project_id", "display_name", "access_key", "secret_access_key"),
+		},
+		"stackit_argus_instance": {
+			To:       "stackit_observability_instance",
+			ImportID: "{project_id},{id}",
+			Attributes: computed(same(map[string]Convert{
+				"plan":    rename("plan_name"),
+				"metrics": argusMetrics,
+				"grafana": argusGrafana,
+			}, "name", "project_id"),
+				"plan_id", "dashboard_url", "is_updatable", "grafana_url", "grafana_initial_admin_password", "grafana_initial_admin_user",
+				"metrics_url", "metrics_push_url", "targets_url", "alerting_url", "logs_url", "logs_push_url",
+				"jaeger_traces_url", "otlp_traces_http_url", "otlp_traces_grpc_url", "zipkin_spans_url"),
+		},
+		"stackit_argus_job": {
+			To:       "stackit_observability_scrapeconfig",
+			ImportID: "{project_id},{argus_instance_id},{name}",
+			Attributes: same(map[string]Convert{
+				"argus_instance_id": rename("instance_id"),
+			}, "name", "project_id", "metrics_path", "scheme", "scrape_interval", "scrape_timeout", "sample_limit", "saml2", "basic_auth", "targets"),
+		},
+		"stackit_argus_credential": {
+			To:         "stackit_observability_credential",
+			Attributes: computed(same(map[string]Convert{}, "project_id", "instance_id"), "username", "password"),
+			Note:       "credentials can't be imported, a new credential is created and the old one has to be deleted manually",
+		},
+		"stackit_secrets_manager_instance": {
+			To:       "stackit_secretsmanager_instance",
+			ImportID: "{project_id},{id}",
+			Attributes: computed(same(map[string]Convert{
+				"acl": rename("acls"),
+			}, "name", "project_id"), "frontend_url", "api_url"),
+		},
+		"stackit_secrets_manager_acl": {
+			Note: "add the CIDR to `acls` of `stackit_secretsmanager_instance`, the ACL is only removed from the state",
+		},
+		"stackit_secrets_manager_user": {
+			To:         "stackit_secretsmanager_user",
+			ImportID:   "{project_id},{instance_id},{id}",
+			Attributes: computed(same(map[string]Convert{}, "project_id", "instance_id", "description", "write_enabled"), "username", "password"),
+		},
+		"stackit_secrets_manager_secret": {
+			Note: "secrets aren't managed by the official provider, the secret is only removed from the state",
+		},
+		"stackit_load_balancer": {
+			To:       "stackit_loadbalancer",
+			ImportID: "{project_id},{region},{name}",
+			Attributes: computed(same(map[string]Convert{
+				"acl":                  nest("options", "access_control"),
+				"private_network_only": nest("options", "private_network_only"),
+				"observability":        nest("options", "observability"),
+			}, "name", "project_id", "external_address", "listeners", "networks", "target_pools"), "private_address", "push_url", "credentials_ref"),
+			Note: "`listeners`, `networks`, `target_pools` and `options` are copied unchanged, review them against the official schema",
+		},
+		"stackit_load_balancer_credential": {
+			To:         "stackit_loadbalancer_observability_credential",
+			ImportID:   "{project_id},{region},{credentials_ref}",
+			Attributes: computed(same(map[string]Convert{}, "project_id", "display_name", "username"), "password", "password_wo", "password_wo_version", "credentials_ref"),
+			Note:       "`password` isn't copied from the state, set it before applying",
+		},
+		"stackit_load_balancer_target_pool": {
+			Note: "target pools are part of `target_pools` of `stackit_loadbalancer`, the target pool is only removed from the state",
+		},
+		"stackit_network": {
+			To:       "stackit_network",
+			ImportID: "{project_id},{id}",
+			Attributes: computed(same(map[string]Convert{
+				"nameservers":      rename("ipv4_nameservers"),
+				"prefixes":         first("ipv4_prefix"),
+				"prefix_length_v4": rename("ipv4_prefix_length"),
+				"gateway_v4":       rename("ipv4_gateway"),
+				"no_gateway_v4":    rename("no_ipv4_gateway"),
+				"nameservers_v6":   rename("ipv6_nameservers"),
+				"prefixes_v6":      first("ipv6_prefix"),
+				"prefix_length_v6": rename("ipv6_prefix_length"),
+				"gateway_v6":       rename("ipv6_gateway"),
+				"no_gateway_v6":    rename("no_ipv6_gateway"),
+			}, "name", "project_id", "routed", "labels"), "public_ip"),
+		},
+		"stackit_network_area": {
+			To:       "stackit_network_area",
+			ImportID: "{organization_id},{id}",
+			Attributes: same(map[string]Convert{
+				"routes": unsupported("routes are managed by `stackit_network_area_route`"),
+			}, "organization_id", "name", "network_ranges", "transfer_network", "default_nameservers",
+				"default_prefix_length", "min_prefix_length", "max_prefix_length", "labels"),
+		},
+		"stackit_server": {
+			To:       "stackit_server",
+			ImportID: "{project_id},{id}",
+			Attributes: computed(same(map[string]Convert{
+				"key_pair_name": rename("keypair_name"),
+				"networks":      unsupported("networks are attached with `stackit_server_network_interface_attach`"),
+			}, "project_id", "name", "machine_type", "availability_zone", "image_id", "boot_volume", "network_interfaces", "user_data", "labels"), "status"),
+		},
+		"stackit_volume": {
+			To:         "stackit_volume",
+			ImportID:   "{project_id},{id}",
+			Attributes: computed(same(map[string]Convert{}, "project_id", "name", "description", "size", "availability_zone", "performance_class", "source", "labels"), "server_id"),
+		},
+		"stackit_volume_attachment": {
+			To:         "stackit_server_volume_attach",
+			ImportID:   "{project_id},{server_id},{volume_id}",
+			Attributes: same(map[string]Convert{}, "project_id", "server_id", "volume_id"),
+		},
+		"stackit_key_pair": {
+			To:         "stackit_key_pair",
+			ImportID:   "{name}",
+			Attributes: computed(same(map[string]Convert{}, "name", "public_key", "labels"), "fingerprint"),
+		},
+		"stackit_security_group": {
+			To:         "stackit_security_group",
+			ImportID:   "{project_id},{id}",
+			Attributes: same(map[string]Convert{}, "project_id", "name", "description", "stateful", "labels"),
+		},
+		"stackit_security_group_rule": {
+			To:       "stackit_security_group_rule",
+			ImportID: "{project_id},{security_group_id},{id}",
+			Attributes: same(map[string]Convert{
+				"protocol":       nest("protocol", "name"),
+				"port_range_min": nest("port_range", "min"),
+				"port_range_max": nest("port_range", "max"),
+			}, "project_id", "security_group_id", "direction", "ether_type", "ip_range", "remote_security_group_id", "description"),
+		},
+		"stackit_public_ip": {
+			To:         "stackit_public_ip",
+			ImportID:   "{project_id},{id}",
+			Attributes: computed(same(map[string]Convert{}, "project_id", "network_interface_id", "labels"), "ip"),
+		},
+		"stackit_service_enablement": {
+			Note: "services are enabled by the official provider when needed, the enablement is only removed from the state",
+		},
+	}
+
+	for _, s := range dataServices {
+		m[fmt.Sprintf("stackit_%s_instance", s)] = Mapping{
+			To:       fmt.Sprintf("stackit_%s_instance", s),
+			ImportID: "{project_id},{id}",
+			Attributes: computed(same(map[string]Convert{
+				"plan": rename("plan_name"),
+				"acl":  joinACL,
+			}, "name", "project_id", "version"), "plan_id", "dashboard_url", "cf_guid", "cf_space_guid", "cf_organization_guid"),
+		}
+		m[fmt.Sprintf("stackit_%s_credential", s)] = Mapping{
+			To:       fmt.Sprintf("stackit_%s_credential", s),
+			ImportID: "{project_id},{instance_id},{id}",
+			Attributes: computed(same(map[string]Convert{}, "project_id", "instance_id"),
+				"host", "hosts", "username", "database_name", "password", "port", "syslog_drain_url", "route_service_url", "uri", "raw_response"),
+		}
+	}
+	return m
+}
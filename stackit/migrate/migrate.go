@@ -0,0 +1,373 @@
+package migrate
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// OfficialSource is the source address of the official STACKIT provider
+const OfficialSource = "stackitcloud/stackit"
+
+const (
+	removedHeader = `# Step 1: delete the configuration of the resources listed below, then run "terraform apply"
+# the resources are removed from the state without being destroyed
+`
+	migratedHeader = `# Step 2: delete removed.tf, replace the source of the "stackit" provider with "` + OfficialSource + `"
+# and run "terraform plan" to review the imports before applying
+`
+)
+
+var (
+	// ignored attributes are provider specific and never migrated or reported
+	ignored = map[string]bool{"id": true, "timeouts": true}
+
+	placeholder  = regexp.MustCompile(`\{([a-z0-9_]+)\}`)
+	invalidLabel = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+)
+
+// Options configure the migration
+type Options struct {
+	// Region is used in import identifiers of regional resources
+	Region string
+}
+
+// Result is the outcome of a migration
+type Result struct {
+	// Removed holds `removed` blocks that drop the resources of this provider from the state
+	Removed []byte
+
+	// Migrated holds the provider requirement, `import` blocks and resources of the official provider
+	Migrated []byte
+
+	// Report lists what was migrated and what needs manual work
+	Report []string
+}
+
+// Run is the entrypoint of the `migrate` subcommand and returns its exit code
+func Run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	statePath := fs.String("state", "terraform.tfstate", "path of the Terraform state, use `terraform state pull` to fetch a remote state")
+	out := fs.String("out", "migration", "directory the generated configuration is written to")
+	region := fs.String("region", "eu01", "region used in import identifiers of regional resources")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s migrate [flags]\n\n", filepath.Base(os.Args[0]))
+		fmt.Fprintf(stderr, "Generates the configuration to migrate resources of this provider to %s.\n\n", OfficialSource)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	b, err := os.ReadFile(*statePath)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed reading state: %s\n", err)
+		return 1
+	}
+	s, err := ReadState(b)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	res, err := Migrate(s, Options{Region: *region})
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		fmt.Fprintf(stderr, "failed creating output directory: %s\n", err)
+		return 1
+	}
+	for name, content := range map[string][]byte{"removed.tf": res.Removed, "migrated.tf": res.Migrated} {
+		if err := os.WriteFile(filepath.Join(*out, name), content, 0o644); err != nil {
+			fmt.Fprintf(stderr, "failed writing %s: %s\n", name, err)
+			return 1
+		}
+	}
+
+	for _, line := range res.Report {
+		fmt.Fprintln(stdout, line)
+	}
+	fmt.Fprintf(stdout, "\nconfiguration written to %s, import identifiers follow the current documentation of %s\n", *out, OfficialSource)
+	return 0
+}
+
+// Migrate converts the resources of this provider in the state to the official provider
+func Migrate(s State, opts Options) (Result, error) {
+	mappings := Mappings()
+	removed := hclwrite.NewEmptyFile()
+	removed.Body().AppendUnstructuredTokens(comment(removedHeader))
+	migrated := hclwrite.NewEmptyFile()
+	migrated.Body().AppendUnstructuredTokens(comment(migratedHeader))
+	migrated.Body().AppendNewline()
+	appendRequiredProviders(migrated.Body())
+
+	report := []string{}
+	for _, r := range s.Resources {
+		if !r.managed() {
+			continue
+		}
+		if r.Module != "" {
+			report = append(report, fmt.Sprintf("%s: skipped, resources in modules have to be migrated in the module", r.address()))
+			continue
+		}
+		m, ok := mappings[r.Type]
+		if !ok {
+			report = append(report, fmt.Sprintf("%s: skipped, unknown resource type", r.address()))
+			continue
+		}
+
+		if m.To == "" {
+			appendRemoved(removed.Body(), r)
+			report = append(report, fmt.Sprintf("%s: no equivalent in %s\n  - %s", r.address(), OfficialSource, m.Note))
+			continue
+		}
+
+		complete := true
+		for _, inst := range r.Instances {
+			lines, ok, err := migrateInstance(migrated.Body(), r, inst, m, opts)
+			if err != nil {
+				return Result{}, fmt.Errorf("failed migrating %s: %w", r.address(), err)
+			}
+			report = append(report, lines...)
+			complete = complete && ok
+		}
+
+		// resources that weren't fully migrated are kept in the state
+		if complete {
+			appendRemoved(removed.Body(), r)
+		}
+	}
+
+	return Result{
+		Removed:  hclwrite.Format(removed.Bytes()),
+		Migrated: hclwrite.Format(migrated.Bytes()),
+		Report:   report,
+	}, nil
+}
+
+// migrateInstance writes the import and resource block of a single instance and returns its report
+// ok is false if the instance was skipped
+func migrateInstance(body *hclwrite.Body, r Resource, inst Instance, m Mapping, opts Options) (report []string, ok bool, err error) {
+	name := label(r.Name, inst.IndexKey)
+	header := fmt.Sprintf("%s -> %s.%s", r.address(), m.To, name)
+	if inst.IndexKey != nil {
+		header = fmt.Sprintf("%s[%v] -> %s.%s", r.address(), inst.IndexKey, m.To, name)
+	}
+	report = []string{header}
+	if m.Note != "" {
+		report = append(report, "  - "+m.Note)
+	}
+
+	id := ""
+	if m.ImportID != "" {
+		if id, err = importID(m.ImportID, inst.Attributes, opts.Region); err != nil {
+			return append(report, "  - skipped and kept in the state, "+err.Error()), false, nil
+		}
+	}
+
+	attrs, issues := m.convert(inst.Attributes)
+	for _, i := range issues {
+		report = append(report, "  - "+i)
+	}
+
+	body.AppendNewline()
+	body.AppendUnstructuredTokens(comment(fmt.Sprintf("# migrated from %s\n", r.address())))
+	if id != "" {
+		imp := body.AppendNewBlock("import", nil).Body()
+		imp.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: m.To}, hcl.TraverseAttr{Name: name}})
+		imp.SetAttributeValue("id", cty.StringVal(id))
+		body.AppendNewline()
+	}
+
+	res := body.AppendNewBlock("resource", []string{m.To, name}).Body()
+	for _, k := range sortedKeys(attrs) {
+		v, err := toValue(attrs[k])
+		if err != nil {
+			return nil, false, fmt.Errorf("attribute `%s`: %w", k, err)
+		}
+		res.SetAttributeValue(k, v)
+	}
+	return report, true, nil
+}
+
+// convert maps the attributes of an instance and returns the issues found
+func (m Mapping) convert(attributes map[string]interface{}) (map[string]interface{}, []string) {
+	out := map[string]interface{}{}
+	issues := []string{}
+	for _, name := range sortedKeys(attributes) {
+		v := attributes[name]
+		if ignored[name] || v == nil {
+			continue
+		}
+		c, ok := m.Attributes[name]
+		if !ok {
+			if isSet(v) {
+				issues = append(issues, fmt.Sprintf("`%s` has no equivalent", name))
+			}
+			continue
+		}
+		if !isSet(v) {
+			if _, isBool := v.(bool); !isBool {
+				continue
+			}
+		}
+		converted, err := c(v)
+		if err != nil {
+			issues = append(issues, fmt.Sprintf("`%s`: %s", name, err))
+			continue
+		}
+		merge(out, converted)
+	}
+	return out, issues
+}
+
+// importID fills the placeholders of the template
+func importID(template string, attributes map[string]interface{}, region string) (string, error) {
+	missing := []string{}
+	id := placeholder.ReplaceAllStringFunc(template, func(p string) string {
+		name := placeholder.FindStringSubmatch(p)[1]
+		if name == "region" {
+			return region
+		}
+		v := attributes[name]
+		if v == nil || fmt.Sprint(v) == "" {
+			missing = append(missing, name)
+			return p
+		}
+		return fmt.Sprint(v)
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("the import identifier is missing `%s`", strings.Join(missing, "`, `"))
+	}
+	return id, nil
+}
+
+func appendRequiredProviders(body *hclwrite.Body) {
+	rp := body.AppendNewBlock("terraform", nil).Body().AppendNewBlock("required_providers", nil).Body()
+	rp.SetAttributeValue("stackit", cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal(OfficialSource),
+	}))
+}
+
+func appendRemoved(body *hclwrite.Body, r Resource) {
+	body.AppendNewline()
+	rm := body.AppendNewBlock("removed", nil).Body()
+	rm.SetAttributeTraversal("from", hcl.Traversal{hcl.TraverseRoot{Name: r.Type}, hcl.TraverseAttr{Name: r.Name}})
+	rm.AppendNewline()
+	rm.AppendNewBlock("lifecycle", nil).Body().SetAttributeValue("destroy", cty.False)
+}
+
+func comment(s string) hclwrite.Tokens {
+	return hclwrite.Tokens{{Type: hclsyntax.TokenComment, Bytes: []byte(s)}}
+}
+
+// label returns the resource name, instances of `count` and `for_each` get their key as suffix
+func label(name string, key interface{}) string {
+	if key == nil {
+		return name
+	}
+	return name + "_" + invalidLabel.ReplaceAllString(fmt.Sprint(key), "_")
+}
+
+// isSet reports if a value differs from its zero value
+func isSet(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return t
+	case string:
+		return t != ""
+	case []interface{}:
+		return len(t) > 0
+	case map[string]interface{}:
+		return len(t) > 0
+	}
+	return true
+}
+
+// merge copies src into dst, objects present in both are merged
+func merge(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if a, ok := dst[k].(map[string]interface{}); ok {
+			if b, ok := v.(map[string]interface{}); ok {
+				merge(a, b)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+// toValue converts a decoded JSON value to a cty value
+func toValue(v interface{}) (cty.Value, error) {
+	switch t := v.(type) {
+	case nil:
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	case bool:
+		return cty.BoolVal(t), nil
+	case string:
+		return cty.StringVal(t), nil
+	case json.Number:
+		return cty.ParseNumberVal(t.String())
+	case int:
+		return cty.NumberIntVal(int64(t)), nil
+	case float64:
+		return cty.NumberFloatVal(t), nil
+	case []interface{}:
+		if len(t) == 0 {
+			return cty.EmptyTupleVal, nil
+		}
+		vals := make([]cty.Value, len(t))
+		for i, item := range t {
+			val, err := toValue(item)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			vals[i] = val
+		}
+		return cty.TupleVal(vals), nil
+	case map[string]interface{}:
+		if len(t) == 0 {
+			return cty.EmptyObjectVal, nil
+		}
+		vals := make(map[string]cty.Value, len(t))
+		for k, item := range t {
+			val, err := toValue(item)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			vals[k] = val
+		}
+		return cty.ObjectVal(vals), nil
+	}
+	return cty.NilVal, fmt.Errorf("unsupported value type %T", v)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
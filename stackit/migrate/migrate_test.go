@@ -0,0 +1,169 @@
+package migrate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+const testState = `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "stackit_project",
+      "name": "example",
+      "provider": "provider[\"registry.terraform.io/schwarzit/stackit\"]",
+      "instances": [
+        {
+          "attributes": {
+            "id": "c1",
+            "container_id": "c1",
+            "parent_container_id": "parent",
+            "name": "example",
+            "billing_ref": "T-123",
+            "owner_email": "owner@example.com",
+            "deletion_protection": false,
+            "labels": null,
+            "timeouts": null
+          }
+        }
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "stackit_postgres_flex_instance",
+      "name": "db",
+      "provider": "provider[\"registry.terraform.io/schwarzit/stackit\"]",
+      "instances": [
+        {
+          "index_key": 0,
+          "attributes": {
+            "id": "i1",
+            "project_id": "p1",
+            "name": "db",
+            "machine_type": "2.4",
+            "replicas": 1,
+            "storage": {"class": "premium-perf6-stackit", "size": 20},
+            "extensions": ["postgis"]
+          }
+        }
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "stackit_mongodb_flex_role",
+      "name": "role",
+      "provider": "provider[\"registry.terraform.io/schwarzit/stackit\"]",
+      "instances": [{"attributes": {"id": "r1"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "stackit_secrets_manager_user",
+      "name": "missing",
+      "provider": "provider[\"registry.terraform.io/schwarzit/stackit\"]",
+      "instances": [{"attributes": {"id": "u1", "project_id": "p1"}}]
+    },
+    {
+      "module": "module.child",
+      "mode": "managed",
+      "type": "stackit_project",
+      "name": "nested",
+      "provider": "provider[\"registry.terraform.io/schwarzit/stackit\"]",
+      "instances": [{"attributes": {"id": "c2"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "null_resource",
+      "name": "other",
+      "provider": "provider[\"registry.terraform.io/hashicorp/null\"]",
+      "instances": [{"attributes": {"id": "n1"}}]
+    }
+  ]
+}`
+
+func TestMigrate(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	s, err := ReadState([]byte(testState))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Migrate(s, Options{Region: "eu01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	removed := string(res.Removed)
+	for _, want := range []string{
+		"from = stackit_project.example",
+		"from = stackit_postgres_flex_instance.db",
+		"from = stackit_mongodb_flex_role.role",
+		"destroy = false",
+	} {
+		if !strings.Contains(removed, want) {
+			t.Errorf("removed.tf doesn't contain %q:\n%s", want, removed)
+		}
+	}
+	for _, unwanted := range []string{"nested", "null_resource", "stackit_secrets_manager_user"} {
+		if strings.Contains(removed, unwanted) {
+			t.Errorf("removed.tf shouldn't contain %q:\n%s", unwanted, removed)
+		}
+	}
+
+	migrated := string(res.Migrated)
+	for _, want := range []string{
+		`source = "stackitcloud/stackit"`,
+		"to = stackit_resourcemanager_project.example",
+		`id = "c1"`,
+		`resource "stackit_resourcemanager_project" "example"`,
+		`owner_email         = "owner@example.com"`,
+		"to = stackit_postgresflex_instance.db_0",
+		`id = "p1,eu01,i1"`,
+		"cpu = 2",
+	} {
+		if !strings.Contains(migrated, want) {
+			t.Errorf("migrated.tf doesn't contain %q:\n%s", want, migrated)
+		}
+	}
+	if strings.Contains(migrated, "stackit_secretsmanager_user") {
+		t.Errorf("resources without import identifier shouldn't be migrated:\n%s", migrated)
+	}
+
+	report := strings.Join(res.Report, "\n")
+	for _, want := range []string{
+		"`billing_ref` has no equivalent",
+		"`extensions` has no equivalent",
+		"stackit_mongodb_flex_role.role: no equivalent",
+		"missing `instance_id`",
+		"module.child.stackit_project.nested: skipped",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report doesn't contain %q:\n%s", want, report)
+		}
+	}
+	if strings.Contains(report, "deletion_protection") {
+		t.Errorf("unset attributes shouldn't be reported:\n%s", report)
+	}
+}
+
+func TestImportID(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	attrs := map[string]interface{}{"project_id": "p1", "id": "i1", "name": ""}
+	if got, err := importID("{project_id},{region},{id}", attrs, "eu01"); err != nil || got != "p1,eu01,i1" {
+		t.Errorf("importID() = %q, %v", got, err)
+	}
+	if _, err := importID("{project_id},{name}", attrs, "eu01"); err == nil {
+		t.Error("expected an error for an empty attribute")
+	}
+}
@@ -0,0 +1,62 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// providerSource is the source address of this provider as stored in the state
+const providerSource = "registry.terraform.io/schwarzit/stackit"
+
+// State is the subset of the Terraform state format used by the migration
+// it's the format of `terraform.tfstate` and `terraform state pull`
+type State struct {
+	Version   int        `json:"version"`
+	Resources []Resource `json:"resources"`
+}
+
+// Resource is a resource in the state
+type Resource struct {
+	Module    string     `json:"module,omitempty"`
+	Mode      string     `json:"mode"`
+	Type      string     `json:"type"`
+	Name      string     `json:"name"`
+	Provider  string     `json:"provider"`
+	Instances []Instance `json:"instances"`
+}
+
+// Instance is a single instance of a resource, there are several if `count` or `for_each` is used
+type Instance struct {
+	IndexKey   interface{}            `json:"index_key,omitempty"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// ReadState parses a Terraform state
+// numbers are kept as json.Number so they're written back unchanged
+func ReadState(b []byte) (State, error) {
+	var s State
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&s); err != nil {
+		return s, fmt.Errorf("failed parsing state: %w", err)
+	}
+	if s.Version != 4 {
+		return s, fmt.Errorf("unsupported state version %d, expected 4", s.Version)
+	}
+	return s, nil
+}
+
+// managed reports if the resource is managed by this provider
+func (r Resource) managed() bool {
+	return r.Mode == "managed" && strings.Contains(r.Provider, `"`+providerSource+`"`)
+}
+
+// address returns the address of the resource in the configuration
+func (r Resource) address() string {
+	if r.Module == "" {
+		return r.Type + "." + r.Name
+	}
+	return r.Module + "." + r.Type + "." + r.Name
+}
@@ -2,6 +2,7 @@ package validate
 
 import (
 	"context"
+	"time"
 
 	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -178,3 +179,19 @@ func PublicIP() *Validator {
 		},
 	}
 }
+
+func RFC3339() *Validator {
+	return &Validator{
+		description: "validate RFC3339 timestamp",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			v, diag := req.ConfigValue.ToStringValue(ctx)
+			if diag.HasError() {
+				resp.Diagnostics.Append(diag...)
+				return
+			}
+			if _, err := time.Parse(time.RFC3339, v.ValueString()); err != nil {
+				resp.Diagnostics.AddError(err.Error(), err.Error())
+			}
+		},
+	}
+}
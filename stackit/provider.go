@@ -10,30 +10,51 @@ import (
 	dataKubernetesCluster "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/kubernetes/cluster"
 	dataKubernetesProject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/kubernetes/project"
 	dataLoadBalancer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/load-balancer"
+	dataMongoDBFlexBackups "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/mongodb-flex/backups"
+	dataMongoDBFlexFlavors "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/mongodb-flex/flavors"
 	dataMongoDBFlexInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/mongodb-flex/instance"
+	dataMongoDBFlexStorages "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/mongodb-flex/storages"
 	dataMongoDBFlexUser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/mongodb-flex/user"
 	dataNetwork "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/network"
 	dataObjectStorageBucket "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/object-storage/bucket"
 	dataObjectStorageCredential "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/object-storage/credential"
 	dataObjectStorageCredentialsGroup "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/object-storage/credentials-group"
 	dataObjectStorageProject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/object-storage/project"
+	dataPostgresFlexFlavors "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/postgres-flex/flavors"
 	dataPostgresFlexInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/postgres-flex/instance"
+	dataPostgresFlexStorages "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/postgres-flex/storages"
 	dataPostgresFlexUser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/postgres-flex/user"
 	dataProject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/project"
 	dataSecretsManagerInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/secrets-manager/instance"
+	dataSecretsManagerSecret "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/secrets-manager/secret"
 	dataSecretsManagerUser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/secrets-manager/user"
 
+	ephemeralArgusCredential "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/ephemeral-resources/argus/credential"
+	ephemeralKubernetesKubeconfig "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/ephemeral-resources/kubernetes/kubeconfig"
+	ephemeralMongoDBFlexUserPassword "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/ephemeral-resources/mongodb-flex/user-password"
+	ephemeralObjectStorageCredential "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/ephemeral-resources/object-storage/credential"
+	ephemeralPostgresFlexUserPassword "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/ephemeral-resources/postgres-flex/user-password"
+	ephemeralSecretsManagerUser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/ephemeral-resources/secrets-manager/user"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/functions"
+
 	resourceArgusCredential "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/argus/credential"
 	resourceArgusInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/argus/instance"
 	resourceArgusJob "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/argus/job"
 	resourceDataServicesCredential "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/data-services/credential"
 	resourceDataServicesInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/data-services/instance"
+	resourceKeyPair "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/key-pair"
 	resourceKubernetesCluster "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/kubernetes/cluster"
 	resourceKubernetesProject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/kubernetes/project"
 	resourceLoadBalancer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/load-balancer"
+	resourceLoadBalancerCredential "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/load-balancer/credential"
+	resourceLoadBalancerTargetPool "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/load-balancer/target-pool"
 	resourceMongoDBFlexInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/mongodb-flex/instance"
+	resourceMongoDBFlexRestore "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/mongodb-flex/restore"
+	resourceMongoDBFlexRole "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/mongodb-flex/role"
 	resourceMongoDBFlexUser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/mongodb-flex/user"
 	resourceNetwork "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/network"
+	resourceNetworkArea "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/network-area"
 	resourceObjectStorageBucket "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/bucket"
 	resourceObjectStorageCredential "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/credential"
 	resourceObjectStorageCredentialsGroup "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/credentials-group"
@@ -41,10 +62,24 @@ import (
 	resourcePostgresFlexInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/postgres-flex/instance"
 	resourcePostgresFlexUser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/postgres-flex/user"
 	resourceProject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/project"
+	resourcePublicIP "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/public-ip"
+	resourceSecretsManagerACL "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/acl"
 	resourceSecretsManagerInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/instance"
+	resourceSecretsManagerSecret "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/secret"
 	resourceSecretsManagerUser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/user"
+	resourceSecurityGroup "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/security-group"
+	resourceSecurityGroupRule "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/security-group-rule"
+	resourceServer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/server"
+	resourceServiceAccount "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/service-account"
+	resourceServiceAccountAccessToken "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/service-account/access-token"
+	resourceServiceAccountKey "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/service-account/key"
+	resourceServiceEnablement "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/service-enablement"
+	resourceVolume "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/volume"
+	resourceVolumeAttachment "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/volume-attachment"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -63,6 +98,15 @@ const (
 	// Key Flow optional env variable (2) using file paths
 	ServiceAccountKeyPath = "STACKIT_SERVICE_ACCOUNT_KEY_PATH"
 	PrivateKeyPath        = "STACKIT_PRIVATE_KEY_PATH"
+
+	// OIDC Flow, uses the service account email of the token flow
+	UseOIDC           = "STACKIT_USE_OIDC"
+	OIDCToken         = "STACKIT_OIDC_TOKEN"
+	OIDCTokenFilePath = "STACKIT_OIDC_TOKEN_FILE_PATH"
+
+	// Credentials file, credentials and endpoints that aren't configured are read from a profile
+	CredentialsFile = "STACKIT_CREDENTIALS_FILE"
+	Profile         = "STACKIT_PROFILE"
 )
 
 // New returns a new STACKIT provider function
@@ -79,6 +123,8 @@ type StackitProvider struct {
 }
 
 var _ = provider.Provider(&StackitProvider{})
+var _ = provider.ProviderWithFunctions(&StackitProvider{})
+var _ = provider.ProviderWithEphemeralResources(&StackitProvider{})
 
 // Provider schema struct
 type providerSchema struct {
@@ -94,8 +140,18 @@ type providerSchema struct {
 	ServiceAccountKeyPath types.String `tfsdk:"service_account_key_path"`
 	PrivateKeyPath        types.String `tfsdk:"private_key_path"`
 
+	// OIDC Flow
+	UseOIDC           types.Bool   `tfsdk:"use_oidc"`
+	OIDCToken         types.String `tfsdk:"oidc_token"`
+	OIDCTokenFilePath types.String `tfsdk:"oidc_token_file_path"`
+
+	// Credentials file
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+	Profile         types.String `tfsdk:"profile"`
+
 	// General
-	EnableTraceContext types.Bool `tfsdk:"enable_trace_context"`
+	EnableTraceContext types.Bool         `tfsdk:"enable_trace_context"`
+	Endpoints          *providerEndpoints `tfsdk:"endpoints"`
 }
 
 // Schema returns the provider's schema
@@ -130,11 +186,35 @@ func (p *StackitProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Optional:            true,
 				MarkdownDescription: "Path to the Private RSA Key.<br />This attribute can also be loaded from `STACKIT_PRIVATE_KEY_PATH` environment variable instead.",
 			},
+			"use_oidc": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Authenticate with an OIDC token of a workload identity, e.g. a CI job, federated with the service account of `service_account_email`.<br />This attribute can also be loaded from `STACKIT_USE_OIDC` environment variable instead.",
+			},
+			"oidc_token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "OIDC token exchanged for STACKIT access tokens when `use_oidc` is enabled.<br />This attribute can also be loaded from `STACKIT_OIDC_TOKEN` environment variable instead.",
+			},
+			"oidc_token_file_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a file containing the OIDC token, it's read again whenever the access token is refreshed.<br />This attribute can also be loaded from `STACKIT_OIDC_TOKEN_FILE_PATH` environment variable instead.",
+			},
+			"credentials_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a YAML file with named profiles of settings. The credentials of the selected profile are used if no credentials are configured or set in the environment, endpoints that aren't configured are read from it as well. Default: `~/.stackit/credentials.yaml`<br />This attribute can also be loaded from `STACKIT_CREDENTIALS_FILE` environment variable instead.",
+			},
+			"profile": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Profile of the credentials file. Default: `default`<br />This attribute can also be loaded from `STACKIT_PROFILE` environment variable instead.",
+			},
 			"enable_trace_context": schema.BoolAttribute{
 				Optional:            true,
 				MarkdownDescription: "Enable trace context. If `true` a `Traceparent` header will be added to the request. Default: `false`",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"endpoints": endpointsBlock(),
+		},
 	}
 }
 
@@ -163,10 +243,15 @@ func (p *StackitProvider) Resources(_ context.Context) []func() resource.Resourc
 		resourceDataServicesInstance.NewOpensearch,
 		resourceDataServicesInstance.NewRabbitMQ,
 		resourceDataServicesInstance.NewRedis,
+		resourceKeyPair.New,
 		resourceKubernetesCluster.New,
 		resourceKubernetesProject.New,
 		resourceLoadBalancer.New,
+		resourceLoadBalancerCredential.New,
+		resourceLoadBalancerTargetPool.New,
 		resourceMongoDBFlexInstance.New,
+		resourceMongoDBFlexRestore.New,
+		resourceMongoDBFlexRole.New,
 		resourceMongoDBFlexUser.New,
 		resourceObjectStorageBucket.New,
 		resourceObjectStorageCredential.New,
@@ -175,9 +260,22 @@ func (p *StackitProvider) Resources(_ context.Context) []func() resource.Resourc
 		resourcePostgresFlexInstance.New,
 		resourcePostgresFlexUser.New,
 		resourceProject.New,
+		resourcePublicIP.New,
+		resourceSecretsManagerACL.New,
 		resourceSecretsManagerInstance.New,
+		resourceSecretsManagerSecret.New,
 		resourceSecretsManagerUser.New,
+		resourceSecurityGroup.New,
+		resourceSecurityGroupRule.New,
+		resourceServer.New,
+		resourceServiceAccount.New,
+		resourceServiceAccountAccessToken.New,
+		resourceServiceAccountKey.New,
+		resourceServiceEnablement.New,
+		resourceVolume.New,
+		resourceVolumeAttachment.New,
 		resourceNetwork.New,
+		resourceNetworkArea.New,
 	}
 }
 
@@ -203,21 +301,49 @@ func (p *StackitProvider) DataSources(context.Context) []func() datasource.DataS
 		dataKubernetesCluster.New,
 		dataKubernetesProject.New,
 		dataLoadBalancer.New,
+		dataMongoDBFlexBackups.New,
+		dataMongoDBFlexFlavors.New,
 		dataMongoDBFlexInstance.New,
+		dataMongoDBFlexStorages.New,
 		dataMongoDBFlexUser.New,
 		dataObjectStorageBucket.New,
 		dataObjectStorageCredential.New,
 		dataObjectStorageCredentialsGroup.New,
 		dataObjectStorageProject.New,
+		dataPostgresFlexFlavors.New,
 		dataPostgresFlexInstance.New,
+		dataPostgresFlexStorages.New,
 		dataPostgresFlexUser.New,
 		dataProject.New,
 		dataSecretsManagerInstance.New,
+		dataSecretsManagerSecret.New,
 		dataSecretsManagerUser.New,
 		dataNetwork.New,
 	}
 }
 
+// EphemeralResources - Defines provider ephemeral resources
+func (p *StackitProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		ephemeralArgusCredential.New,
+		ephemeralKubernetesKubeconfig.New,
+		ephemeralMongoDBFlexUserPassword.New,
+		ephemeralObjectStorageCredential.New,
+		ephemeralPostgresFlexUserPassword.New,
+		ephemeralSecretsManagerUser.New,
+	}
+}
+
+// Functions - Defines provider functions
+func (p *StackitProvider) Functions(context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewKnownRanges,
+		functions.NewParseKubeconfig,
+		functions.NewPostgresURI,
+		functions.NewValidateProjectID,
+	}
+}
+
 var md = `
 The STACKIT provider is a project developed and maintained by the STACKIT community within Schwarz IT. Please note that it is not an official provider endorsed or maintained by STACKIT.
 
@@ -228,7 +354,7 @@ The provider is built using Terraform's plugin framework, therefore we recommend
 ## Authentication
 
 Before you can start using the client, you will need to create a STACKIT Service Account in your project and assign it the appropriate permissions (i.e. ` + "`project.owner`)." + `
-After the service account has been created, you can authenticate to the client using the ` + "`Key flow`" + `  (recommended) or with the static ` + "`Token flow`" + ` (less secure as the token is long-lived).
+After the service account has been created, you can authenticate to the client using the ` + "`Key flow`" + `  (recommended), the ` + "`OIDC flow`" + ` for workloads like CI jobs or with the static ` + "`Token flow`" + ` (less secure as the token is long-lived).
 
 ### Key flow
 
@@ -248,6 +374,20 @@ After the service account has been created, you can authenticate to the client u
    export STACKIT_PRIVATE_KEY="..."
    ` + "```" + `
 
+### OIDC flow
+
+The OIDC flow exchanges a token issued by an identity provider, e.g. GitHub Actions or GitLab CI, for short-lived STACKIT access tokens, so no service account secret has to be stored. The identity has to be federated with the service account. Access tokens are refreshed during long runs.
+
+1. Set the following environment variables or configure the provider directly (example below)
+
+   ` + "```bash" + `
+   export STACKIT_USE_OIDC=true
+   export STACKIT_SERVICE_ACCOUNT_EMAIL=email
+   export STACKIT_OIDC_TOKEN_FILE_PATH=/path/to/token # or STACKIT_OIDC_TOKEN
+   ` + "```" + `
+
+2. In GitHub Actions the token is requested from the runner if neither is set, the job needs the ` + "`id-token: write`" + ` permission
+
 ### Token flow
 
 1. Set the following environment variables or configure the provider directly (example below)
@@ -257,5 +397,23 @@ After the service account has been created, you can authenticate to the client u
    export STACKIT_SERVICE_ACCOUNT_TOKEN=token
    ` + "```" + `
 
+### Credentials file
+
+Settings can be kept in named profiles of a credentials file, similar to AWS profiles. Settings configured in the provider block or set in the environment take precedence over the profile.
+
+` + "```yaml" + `
+# ~/.stackit/credentials.yaml
+default:
+  service_account_key_path: ~/.stackit/sa_key.json
+  private_key_path: ~/.stackit/private_key.pem
+qa:
+  service_account_email: email
+  service_account_token: token
+  endpoints:
+    ske: https://ske.api.qa.stackit.cloud
+` + "```" + `
+
+Select a profile with the ` + "`profile`" + ` attribute or the ` + "`STACKIT_PROFILE`" + ` environment variable. Together with the ` + "`endpoints`" + ` block, provider aliases can target different environments in the same configuration.
+
 &nbsp;
 `
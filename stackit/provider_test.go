@@ -0,0 +1,86 @@
+package stackit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// resourceOnlyAttributes lists resource attributes that aren't exposed by the matching data source
+// because they're secrets, only used during creation or only control resource behavior
+// they must match the attributes the data sources exclude when deriving their schema
+// labels of the flex instances aren't returned by the API
+var resourceOnlyAttributes = map[string][]string{
+	"stackit_mongodb_flex_instance":     {"allow_replace_on_version_change", "clone_from", "labels"},
+	"stackit_mongodb_flex_user":         {"password", "password_rotation_trigger", "uri"},
+	"stackit_object_storage_credential": {"access_key", "secret_access_key"},
+	"stackit_postgres_flex_instance":    {"allow_replace_on_version_change", "labels", "options"},
+	"stackit_postgres_flex_user":        {"password", "uri"},
+	"stackit_project":                   {"owner_email"},
+	"stackit_secrets_manager_secret":    {"cas"},
+	"stackit_secrets_manager_user":      {"password"},
+}
+
+func init() {
+	for _, svc := range []string{"elasticsearch", "logme", "mariadb", "opensearch", "postgres", "rabbitmq", "redis"} {
+		resourceOnlyAttributes[fmt.Sprintf("stackit_%s_credential", svc)] = []string{"raw_response"}
+	}
+}
+
+func TestDataSourcesCoverResourceAttributes(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	p := New("test")()
+
+	dataSources := map[string]dschema.Schema{}
+	for _, f := range p.DataSources(ctx) {
+		d := f()
+		md := datasource.MetadataResponse{}
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "stackit"}, &md)
+		res := datasource.SchemaResponse{}
+		d.Schema(ctx, datasource.SchemaRequest{}, &res)
+		if res.Diagnostics.HasError() {
+			t.Fatalf("%s: failed to build data source schema: %v", md.TypeName, res.Diagnostics)
+		}
+		dataSources[md.TypeName] = res.Schema
+	}
+
+	for _, f := range p.Resources(ctx) {
+		r := f()
+		md := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "stackit"}, &md)
+		ds, ok := dataSources[md.TypeName]
+		if !ok {
+			continue
+		}
+		res := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &res)
+		if res.Diagnostics.HasError() {
+			t.Fatalf("%s: failed to build resource schema: %v", md.TypeName, res.Diagnostics)
+		}
+
+		missing := map[string]bool{}
+		for _, name := range common.MissingAttributes(res.Schema, ds) {
+			missing[name] = true
+		}
+		for _, name := range resourceOnlyAttributes[md.TypeName] {
+			if !missing[name] {
+				t.Errorf("%s: resource only attribute '%s' is exposed by the data source, remove it from the list", md.TypeName, name)
+			}
+			delete(missing, name)
+		}
+		for name := range missing {
+			t.Errorf("%s: resource attribute '%s' is missing in the data source schema", md.TypeName, name)
+		}
+	}
+}